@@ -2,12 +2,13 @@ package app
 
 import (
 	"context"
-	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"fmt"
+	"github.com/ivanbulyk/logistics_engine_api/internal/broker"
 	"github.com/ivanbulyk/logistics_engine_api/internal/config"
+	"github.com/ivanbulyk/logistics_engine_api/internal/gatewayapp"
 	"github.com/ivanbulyk/logistics_engine_api/internal/grpcapp"
 	"github.com/ivanbulyk/logistics_engine_api/internal/httpapp"
 	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
-	"github.com/ivanbulyk/logistics_engine_api/internal/repository/memory"
 	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
 	"golang.org/x/sync/errgroup"
 	stndlog "log"
@@ -21,23 +22,37 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// eventBufferSize is a number of events buffered for each watcher
+const eventBufferSize = 256
+
 type App struct {
-	GRPCApp *grpcapp.App
-	HTTPApp *httpapp.App
+	GRPCApp     *grpcapp.App
+	HTTPApp     *httpapp.App
+	GatewayApp  *gatewayapp.App
+	EventBroker *broker.Broker
 }
 
 // New returns an App instance.
-func New(log *slog.Logger, grpcPort int, httpAddr string, srvMetrics *grpcprom.ServerMetrics, reg *prometheus.Registry) *App {
+func New(log *slog.Logger, repository Repository, grpcPort int, httpAddr string, gatewayAddr string, metrics Metrics, reg *prometheus.Registry) (*App, error) {
 
-	repository := memory.New()
+	eventBroker := broker.New(eventBufferSize)
 
-	logisticsEngineService := logistics_engine.NewLogisticsEngine(log, repository, repository)
-	grpcApp := grpcapp.New(grpcPort, log, logisticsEngineService, srvMetrics)
+	logisticsEngineService := logistics_engine.NewLogisticsEngine(log, repository, repository, eventBroker)
+	if err := reg.Register(logisticsEngineService); err != nil {
+		return nil, err
+	}
+	grpcApp := grpcapp.New(grpcPort, log, logisticsEngineService, metrics.Server, metrics.Options...)
 	httpApp := httpapp.New(httpAddr, log, reg)
-	return &App{
-		GRPCApp: grpcApp,
-		HTTPApp: httpApp,
+	gatewayApp, err := gatewayapp.New(gatewayAddr, fmt.Sprintf("localhost:%d", grpcPort), log, metrics.Client, metrics.Options...)
+	if err != nil {
+		return nil, err
 	}
+	return &App{
+		GRPCApp:     grpcApp,
+		HTTPApp:     httpApp,
+		GatewayApp:  gatewayApp,
+		EventBroker: eventBroker,
+	}, nil
 }
 
 // MustRun is wrapper around run() and it panics if any error occurs.
@@ -66,15 +81,22 @@ func run() error {
 
 	g, ctx := errgroup.WithContext(ctx)
 
-	srvMetrics := grpcprom.NewServerMetrics(
-		grpcprom.WithServerHandlingTimeHistogram(
-			grpcprom.WithHistogramBuckets([]float64{1}), // 0.001, 0.01, 0.1, 0.3, 0.6, 1, 3, 6, 9, 20, 30, 60, 90, 120
-		),
-	)
 	reg := prometheus.NewRegistry()
-	reg.MustRegister(srvMetrics)
+	metrics, err := newMetrics(cfg, reg)
+	if err != nil {
+		return err
+	}
 
-	application := New(log, gRPCPort, config.METRICS_SERVER, srvMetrics, reg)
+	repository, closeRepository, err := newRepository(log, cfg)
+	if err != nil {
+		return err
+	}
+
+	application, err := New(log, repository, gRPCPort, config.METRICS_SERVER, cfg.GatewayAddr, metrics, reg)
+	if err != nil {
+		closeRepository()
+		return err
+	}
 
 	g.Go(func() error {
 		application.GRPCApp.MustRun()
@@ -87,6 +109,10 @@ func run() error {
 		return nil
 	})
 
+	g.Go(func() error {
+		return application.GatewayApp.Run()
+	})
+
 	// handle termination
 	select {
 	case <-quit:
@@ -106,6 +132,10 @@ func run() error {
 
 	log.Info("shutting down servers, please wait...")
 
+	// end watch streams, otherwise they hold graceful shutdown
+	application.EventBroker.Close()
+
+	application.GatewayApp.Stop(timeoutCtx)
 	application.GRPCApp.Stop()
 	application.HTTPApp.Stop(timeoutCtx)
 
@@ -114,5 +144,9 @@ func run() error {
 		stndlog.Fatal(err)
 	}
 
+	if err := closeRepository(); err != nil {
+		log.Error("failed to close repository", logging.Err(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/ivanbulyk/logistics_engine_api/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Metrics instrument gRPC calls of the server and of the gateway.
+type Metrics struct {
+	Server *grpcprom.ServerMetrics
+	// Client is nil unless client metrics are enabled
+	Client *grpcprom.ClientMetrics
+	// Options are passed to every interceptor, they attach exemplars if enabled
+	Options []grpcprom.Option
+}
+
+// newMetrics creates gRPC metrics configured by cfg and registers them on reg.
+func newMetrics(cfg *config.ServerAppConfig, reg *prometheus.Registry) (Metrics, error) {
+	const opLabel = "app.newMetrics"
+
+	buckets, err := parseBuckets(cfg.MetricsHistogramBuckets)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("%s: histogram buckets: %w", opLabel, err)
+	}
+	exemplars, err := strconv.ParseBool(cfg.MetricsExemplars)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("%s: exemplars: %w", opLabel, err)
+	}
+	client, err := strconv.ParseBool(cfg.MetricsClient)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("%s: client: %w", opLabel, err)
+	}
+	clientStream, err := strconv.ParseBool(cfg.MetricsClientStream)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("%s: client stream: %w", opLabel, err)
+	}
+
+	metrics := Metrics{
+		Server: grpcprom.NewServerMetrics(
+			grpcprom.WithServerHandlingTimeHistogram(grpcprom.WithHistogramBuckets(buckets)),
+		),
+	}
+	if err := reg.Register(metrics.Server); err != nil {
+		return Metrics{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	if client {
+		opts := []grpcprom.ClientMetricsOption{
+			grpcprom.WithClientHandlingTimeHistogram(grpcprom.WithHistogramBuckets(buckets)),
+		}
+		if clientStream {
+			opts = append(opts,
+				grpcprom.WithClientStreamSendHistogram(grpcprom.WithHistogramBuckets(buckets)),
+				grpcprom.WithClientStreamRecvHistogram(grpcprom.WithHistogramBuckets(buckets)),
+			)
+		}
+		metrics.Client = grpcprom.NewClientMetrics(opts...)
+		if err := reg.Register(metrics.Client); err != nil {
+			return Metrics{}, fmt.Errorf("%s: %w", opLabel, err)
+		}
+	}
+
+	if exemplars {
+		metrics.Options = append(metrics.Options, grpcprom.WithExemplarFromContext(exemplarFromContext))
+	}
+
+	return metrics, nil
+}
+
+// exemplarFromContext labels metrics of sampled calls with the trace id otelgrpc put in ctx
+func exemplarFromContext(ctx context.Context) prometheus.Labels {
+	if span := trace.SpanContextFromContext(ctx); span.IsSampled() {
+		return prometheus.Labels{"traceID": span.TraceID().String()}
+	}
+	return nil
+}
+
+// parseBuckets parses comma separated upper bounds of histogram buckets, they must increase
+func parseBuckets(s string) ([]float64, error) {
+	var buckets []float64
+	for _, field := range strings.Split(s, ",") {
+		bucket, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	if !slices.IsSorted(buckets) || len(slices.Compact(slices.Clone(buckets))) != len(buckets) {
+		return nil, fmt.Errorf("buckets %v must increase", buckets)
+	}
+	return buckets, nil
+}
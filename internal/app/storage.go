@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"github.com/ivanbulyk/logistics_engine_api/internal/config"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/disk"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/memory"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/postgres"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/sqlite"
+	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// Repository is implemented by every storage backend of the service
+type Repository interface {
+	logistics_engine.DeliveryUnitSaver
+	logistics_engine.ReportProvider
+}
+
+// newRepository opens storage backend selected by cfg, returned function releases it.
+func newRepository(log *slog.Logger, cfg *config.ServerAppConfig) (Repository, func() error, error) {
+	const opLabel = "app.newRepository"
+
+	switch cfg.Storage {
+	case config.StorageMemory:
+		return memory.New(), func() error { return nil }, nil
+
+	case config.StorageDisk:
+		fsync, err := disk.ParseFsyncPolicy(cfg.StorageFsync)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		fsyncInterval, err := time.ParseDuration(cfg.StorageFsyncInterval)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: fsync interval: %w", opLabel, err)
+		}
+		snapshotEvery, err := strconv.Atoi(cfg.StorageSnapshotEvery)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: snapshot every: %w", opLabel, err)
+		}
+
+		repository, err := disk.Open(log, disk.Options{
+			Dir:           cfg.StorageDir,
+			Fsync:         fsync,
+			FsyncInterval: fsyncInterval,
+			SnapshotEvery: snapshotEvery,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		return repository, repository.Close, nil
+
+	case config.StorageSQLite:
+		repository, err := sqlite.Open(context.Background(), cfg.StorageSQLitePath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		return repository, repository.Close, nil
+
+	case config.StoragePostgres:
+		opts, err := postgresPoolOptions(cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		repository, err := postgres.Open(context.Background(), cfg.PostgresDSN, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		return repository, repository.Close, nil
+	}
+
+	return nil, nil, fmt.Errorf("%s: unknown storage %q", opLabel, cfg.Storage)
+}
+
+func postgresPoolOptions(cfg *config.ServerAppConfig) (postgres.PoolOptions, error) {
+	maxConns, err := strconv.ParseInt(cfg.PostgresMaxConns, 10, 32)
+	if err != nil {
+		return postgres.PoolOptions{}, fmt.Errorf("max conns: %w", err)
+	}
+	minConns, err := strconv.ParseInt(cfg.PostgresMinConns, 10, 32)
+	if err != nil {
+		return postgres.PoolOptions{}, fmt.Errorf("min conns: %w", err)
+	}
+	maxConnLifetime, err := time.ParseDuration(cfg.PostgresMaxConnLifetime)
+	if err != nil {
+		return postgres.PoolOptions{}, fmt.Errorf("max conn lifetime: %w", err)
+	}
+	maxConnIdleTime, err := time.ParseDuration(cfg.PostgresMaxConnIdleTime)
+	if err != nil {
+		return postgres.PoolOptions{}, fmt.Errorf("max conn idle time: %w", err)
+	}
+
+	return postgres.PoolOptions{
+		MaxConns:        int32(maxConns),
+		MinConns:        int32(minConns),
+		MaxConnLifetime: maxConnLifetime,
+		MaxConnIdleTime: maxConnIdleTime,
+	}, nil
+}
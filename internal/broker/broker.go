@@ -0,0 +1,105 @@
+package broker
+
+import (
+	"sync"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+)
+
+// Broker fans out cargo unit events to subscribers.
+// Publish never blocks: a subscriber whose buffer is full is unsubscribed
+// and its channel is closed, so the slow reader can not stall ingestion.
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+	bufferSize  int
+	done        chan struct{}
+	closed      bool
+}
+
+type subscriber struct {
+	filter model.CargoUnitEventFilter
+	events chan model.CargoUnitEvent
+}
+
+// New creates a new broker buffering up to bufferSize events per subscriber
+func New(bufferSize int) *Broker {
+	return &Broker{
+		subscribers: make(map[*subscriber]struct{}),
+		bufferSize:  bufferSize,
+		done:        make(chan struct{}),
+	}
+}
+
+// Publish delivers event to every subscriber with matching filter.
+func (b *Broker) Publish(event model.CargoUnitEvent) {
+	var slow []*subscriber
+
+	b.mu.RLock()
+	for s := range b.subscribers {
+		if !s.filter.Match(event) {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, s := range slow {
+		b.remove(s)
+	}
+}
+
+// Subscribe returns channel receiving events matching filter and function releasing the subscription.
+// The channel is closed once the subscription is released or the subscriber falls behind.
+func (b *Broker) Subscribe(filter model.CargoUnitEventFilter) (<-chan model.CargoUnitEvent, func()) {
+	s := &subscriber{
+		filter: filter,
+		events: make(chan model.CargoUnitEvent, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		close(s.events)
+	} else {
+		b.subscribers[s] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	return s.events, func() { b.remove(s) }
+}
+
+// Done returns channel closed when the broker is closed.
+func (b *Broker) Done() <-chan struct{} {
+	return b.done
+}
+
+// Close closes Done channel and then channels of all subscribers.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	close(b.done)
+	for s := range b.subscribers {
+		delete(b.subscribers, s)
+		close(s.events)
+	}
+}
+
+func (b *Broker) remove(s *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exist := b.subscribers[s]; !exist {
+		return
+	}
+	delete(b.subscribers, s)
+	close(s.events)
+}
@@ -0,0 +1,137 @@
+package broker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+)
+
+func movedEvent(id int64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{Type: model.CargoUnitEventMoved, CargoUnitId: id}
+}
+
+func reachedEvent(id, warehouseID int64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{
+		Type:                 model.CargoUnitEventReachedWarehouse,
+		CargoUnitId:          id,
+		UnitReachedWarehouse: model.UnitReachedWarehouse{Announcement: model.WarehouseAnnouncement{CargoUnitId: id, WarehouseId: warehouseID}},
+	}
+}
+
+// drain returns events buffered in events and whether the channel is closed
+func drain(events <-chan model.CargoUnitEvent) ([]model.CargoUnitEvent, bool) {
+	var got []model.CargoUnitEvent
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return got, true
+			}
+			got = append(got, event)
+		default:
+			return got, false
+		}
+	}
+}
+
+func TestPublishMatchesFilter(t *testing.T) {
+	published := []model.CargoUnitEvent{movedEvent(1), movedEvent(2), reachedEvent(1, 5), reachedEvent(2, 6), reachedEvent(3, 5)}
+
+	tests := []struct {
+		name   string
+		filter model.CargoUnitEventFilter
+		want   []model.CargoUnitEvent
+	}{
+		{name: "every event", want: published},
+		{name: "cargo units", filter: model.CargoUnitEventFilter{CargoUnitIds: []int64{1, 3}}, want: []model.CargoUnitEvent{movedEvent(1), reachedEvent(1, 5), reachedEvent(3, 5)}},
+		{name: "warehouse", filter: model.CargoUnitEventFilter{WarehouseId: 5}, want: []model.CargoUnitEvent{reachedEvent(1, 5), reachedEvent(3, 5)}},
+		{name: "cargo units at warehouse", filter: model.CargoUnitEventFilter{CargoUnitIds: []int64{2, 3}, WarehouseId: 5}, want: []model.CargoUnitEvent{reachedEvent(3, 5)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(len(published))
+			events, unsubscribe := b.Subscribe(tt.filter)
+			defer unsubscribe()
+
+			for _, event := range published {
+				b.Publish(event)
+			}
+
+			got, closed := drain(events)
+			if closed {
+				t.Fatalf("got subscription closed, want open")
+			}
+			if !slices.EqualFunc(got, tt.want, func(a, b model.CargoUnitEvent) bool {
+				return a.CargoUnitId == b.CargoUnitId && a.Type == b.Type && a.UnitReachedWarehouse.Equal(b.UnitReachedWarehouse)
+			}) {
+				t.Fatalf("got events %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublishEvictsSlowSubscriber(t *testing.T) {
+	b := New(2)
+	slow, unsubscribeSlow := b.Subscribe(model.CargoUnitEventFilter{})
+	defer unsubscribeSlow()
+	other, unsubscribeOther := b.Subscribe(model.CargoUnitEventFilter{CargoUnitIds: []int64{2}})
+	defer unsubscribeOther()
+
+	// the slow subscriber reads nothing, the third event of unit 1 does not fit its buffer
+	for _, event := range []model.CargoUnitEvent{movedEvent(1), movedEvent(1), movedEvent(1), movedEvent(2)} {
+		b.Publish(event)
+	}
+
+	got, closed := drain(slow)
+	if !closed || len(got) != 2 {
+		t.Fatalf("got %d events and closed %v of slow subscriber, want 2 buffered events and closed", len(got), closed)
+	}
+	got, closed = drain(other)
+	if closed || len(got) != 1 || got[0].CargoUnitId != 2 {
+		t.Fatalf("got events %v and closed %v of other subscriber, want the event of unit 2 and open", got, closed)
+	}
+
+	// publishing to the evicted subscriber and releasing it again is a no-op
+	b.Publish(movedEvent(1))
+	unsubscribeSlow()
+}
+
+func TestUnsubscribe(t *testing.T) {
+	b := New(1)
+	events, unsubscribe := b.Subscribe(model.CargoUnitEventFilter{})
+
+	unsubscribe()
+	unsubscribe()
+	b.Publish(movedEvent(1))
+
+	if got, closed := drain(events); !closed || len(got) != 0 {
+		t.Fatalf("got events %v and closed %v, want no events and closed", got, closed)
+	}
+}
+
+func TestClose(t *testing.T) {
+	b := New(1)
+	events, unsubscribe := b.Subscribe(model.CargoUnitEventFilter{})
+
+	b.Close()
+	b.Close()
+
+	select {
+	case <-b.Done():
+	default:
+		t.Fatalf("got Done open, want closed")
+	}
+	if _, closed := drain(events); !closed {
+		t.Fatalf("got subscription open, want closed")
+	}
+	unsubscribe()
+
+	// subscribing to a closed broker returns a closed channel
+	events, unsubscribe = b.Subscribe(model.CargoUnitEventFilter{})
+	defer unsubscribe()
+	b.Publish(movedEvent(1))
+	if got, closed := drain(events); !closed || len(got) != 0 {
+		t.Fatalf("got events %v and closed %v after Close, want no events and closed", got, closed)
+	}
+}
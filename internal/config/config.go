@@ -6,9 +6,30 @@ import (
 )
 
 const (
-	envServerServiceHost     = "SERVER_SERVICE_HOST"
-	envServerServicePort     = "SERVER_SERVICE_PORT"
-	envServerServiceLogLevel = "SERVER_SERVICE_LOG_LEVEL"
+	envServerServiceHost       = "SERVER_SERVICE_HOST"
+	envServerServicePort       = "SERVER_SERVICE_PORT"
+	envServerServiceLogLevel   = "SERVER_SERVICE_LOG_LEVEL"
+	envGatewayServiceAddr      = "GATEWAY_SERVICE_ADDR"
+	envStorage                 = "STORAGE"
+	envStorageDir              = "STORAGE_DIR"
+	envStorageFsync            = "STORAGE_FSYNC"
+	envStorageFsyncInterval    = "STORAGE_FSYNC_INTERVAL"
+	envStorageSnapshotEvery    = "STORAGE_SNAPSHOT_EVERY"
+	envStorageSQLitePath       = "STORAGE_SQLITE_PATH"
+	envPostgresDSN             = "POSTGRES_DSN"
+	envPostgresMaxConns        = "POSTGRES_MAX_CONNS"
+	envPostgresMinConns        = "POSTGRES_MIN_CONNS"
+	envPostgresMaxConnLifetime = "POSTGRES_MAX_CONN_LIFETIME"
+	envPostgresMaxConnIdleTime = "POSTGRES_MAX_CONN_IDLE_TIME"
+	envMetricsHistogramBuckets = "METRICS_HISTOGRAM_BUCKETS"
+	envMetricsExemplars        = "METRICS_EXEMPLARS"
+	envMetricsClient           = "METRICS_CLIENT"
+	envMetricsClientStream     = "METRICS_CLIENT_STREAM"
+
+	StorageMemory   = "memory"
+	StorageDisk     = "disk"
+	StorageSQLite   = "sqlite"
+	StoragePostgres = "postgres"
 
 	METRICS_SERVER          = "0.0.0.0:50052"
 	METRICS_SERVER_ENDPOINT = "http://0.0.0.0:50052/metrics"
@@ -16,9 +37,37 @@ const (
 
 // ServerAppConfig ...
 type ServerAppConfig struct {
-	Host     string
-	Port     string
-	LogLevel string
+	Host        string
+	Port        string
+	LogLevel    string
+	GatewayAddr string
+	// Storage is a repository backend, StorageMemory, StorageDisk, StorageSQLite or StoragePostgres
+	Storage string
+	// StorageDir keeps files of the disk storage
+	StorageDir string
+	// StorageFsync is a fsync policy of the disk storage: always, interval or never
+	StorageFsync string
+	// StorageFsyncInterval is a duration between syncs of the interval fsync policy
+	StorageFsyncInterval string
+	// StorageSnapshotEvery is a number of disk storage writes after which a snapshot is taken
+	StorageSnapshotEvery string
+	// StorageSQLitePath is a database file of the sqlite storage
+	StorageSQLitePath string
+	// PostgresDSN is a connection string of the postgres storage
+	PostgresDSN string
+	// PostgresMaxConns and PostgresMinConns bound the number of pooled connections
+	PostgresMaxConns string
+	PostgresMinConns string
+	// PostgresMaxConnLifetime and PostgresMaxConnIdleTime are durations after which pooled connection is closed
+	PostgresMaxConnLifetime string
+	PostgresMaxConnIdleTime string
+	// MetricsHistogramBuckets is a comma separated list of upper bounds of gRPC handling time buckets in seconds
+	MetricsHistogramBuckets string
+	// MetricsExemplars labels metrics of sampled calls with their trace ids
+	MetricsExemplars string
+	// MetricsClient instruments gRPC calls of the gateway, MetricsClientStream adds histograms of messages of its streams
+	MetricsClient       string
+	MetricsClientStream string
 }
 
 // GetCombinedAddress with Host and Port
@@ -40,5 +89,69 @@ func (cfg *ServerAppConfig) LoadFromEnv() {
 	if len(cfg.LogLevel) == 0 {
 		cfg.LogLevel = "local"
 	}
+	cfg.GatewayAddr = os.Getenv(envGatewayServiceAddr)
+	if len(cfg.GatewayAddr) == 0 {
+		cfg.GatewayAddr = "0.0.0.0:8080"
+	}
+	cfg.Storage = os.Getenv(envStorage)
+	if len(cfg.Storage) == 0 {
+		cfg.Storage = StorageMemory
+	}
+	cfg.StorageDir = os.Getenv(envStorageDir)
+	if len(cfg.StorageDir) == 0 {
+		cfg.StorageDir = "data"
+	}
+	cfg.StorageFsync = os.Getenv(envStorageFsync)
+	if len(cfg.StorageFsync) == 0 {
+		cfg.StorageFsync = "always"
+	}
+	cfg.StorageFsyncInterval = os.Getenv(envStorageFsyncInterval)
+	if len(cfg.StorageFsyncInterval) == 0 {
+		cfg.StorageFsyncInterval = "1s"
+	}
+	cfg.StorageSnapshotEvery = os.Getenv(envStorageSnapshotEvery)
+	if len(cfg.StorageSnapshotEvery) == 0 {
+		cfg.StorageSnapshotEvery = "10000"
+	}
+	cfg.StorageSQLitePath = os.Getenv(envStorageSQLitePath)
+	if len(cfg.StorageSQLitePath) == 0 {
+		cfg.StorageSQLitePath = "data/logistics.db"
+	}
+	cfg.PostgresDSN = os.Getenv(envPostgresDSN)
+	if len(cfg.PostgresDSN) == 0 {
+		cfg.PostgresDSN = "postgres://postgres@localhost:5432/logistics"
+	}
+	cfg.PostgresMaxConns = os.Getenv(envPostgresMaxConns)
+	if len(cfg.PostgresMaxConns) == 0 {
+		cfg.PostgresMaxConns = "10"
+	}
+	cfg.PostgresMinConns = os.Getenv(envPostgresMinConns)
+	if len(cfg.PostgresMinConns) == 0 {
+		cfg.PostgresMinConns = "0"
+	}
+	cfg.PostgresMaxConnLifetime = os.Getenv(envPostgresMaxConnLifetime)
+	if len(cfg.PostgresMaxConnLifetime) == 0 {
+		cfg.PostgresMaxConnLifetime = "1h"
+	}
+	cfg.PostgresMaxConnIdleTime = os.Getenv(envPostgresMaxConnIdleTime)
+	if len(cfg.PostgresMaxConnIdleTime) == 0 {
+		cfg.PostgresMaxConnIdleTime = "30m"
+	}
+	cfg.MetricsHistogramBuckets = os.Getenv(envMetricsHistogramBuckets)
+	if len(cfg.MetricsHistogramBuckets) == 0 {
+		cfg.MetricsHistogramBuckets = "0.001,0.01,0.1,0.3,0.6,1,3,6,9,20,30,60,90,120"
+	}
+	cfg.MetricsExemplars = os.Getenv(envMetricsExemplars)
+	if len(cfg.MetricsExemplars) == 0 {
+		cfg.MetricsExemplars = "true"
+	}
+	cfg.MetricsClient = os.Getenv(envMetricsClient)
+	if len(cfg.MetricsClient) == 0 {
+		cfg.MetricsClient = "false"
+	}
+	cfg.MetricsClientStream = os.Getenv(envMetricsClientStream)
+	if len(cfg.MetricsClientStream) == 0 {
+		cfg.MetricsClientStream = "false"
+	}
 
 }
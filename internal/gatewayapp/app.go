@@ -0,0 +1,88 @@
+package gatewayapp
+
+import (
+	"context"
+	"fmt"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logistics/httpserver"
+	"log/slog"
+	"net/http"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type App struct {
+	log         *slog.Logger
+	httpServer  *http.Server
+	grpcConn    *grpc.ClientConn
+	gatewayAddr string
+}
+
+// New creates new HTTP/JSON gateway app proxying calls to gRPC server at grpcEndpoint.
+// Calls to gRPC server are instrumented by clientMetrics unless it is nil, metricsOpts are passed to its interceptors.
+func New(gatewayAddr string, grpcEndpoint string, log *slog.Logger, clientMetrics *grpcprom.ClientMetrics, metricsOpts ...grpcprom.Option) (*App, error) {
+	const opLabel = "gatewayapp.New"
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if clientMetrics != nil {
+		dialOpts = append(dialOpts,
+			grpc.WithChainUnaryInterceptor(clientMetrics.UnaryClientInterceptor(metricsOpts...)),
+			grpc.WithChainStreamInterceptor(clientMetrics.StreamClientInterceptor(metricsOpts...)),
+		)
+	}
+
+	conn, err := grpc.NewClient(grpcEndpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	httpServer, err := httpserver.NewGatewayServer(gatewayAddr, log, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &App{
+		log:         log,
+		httpServer:  httpServer,
+		grpcConn:    conn,
+		gatewayAddr: gatewayAddr,
+	}, nil
+}
+
+// MustRun runs gateway server and panics if any error occurs.
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// Run runs gateway server.
+func (a *App) Run() error {
+	const opLabel = "gatewayapp.Run"
+
+	a.log.Info("gateway server listening", slog.String("addr", a.gatewayAddr))
+	if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		a.log.Error("failed to serve gateway", logging.Err(err))
+		return fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return nil
+}
+
+// Stop stops gateway server and closes its connection to gRPC server.
+func (a *App) Stop(timeoutCtx context.Context) {
+	const opLabel = "gatewayapp.Stop"
+
+	log := a.log.With(slog.String("opLabel", opLabel))
+	log.Info("gateway server shutdown")
+
+	if err := a.httpServer.Shutdown(timeoutCtx); err != nil {
+		log.Error("failed to shutdown gateway server", logging.Err(err))
+	}
+	if err := a.grpcConn.Close(); err != nil {
+		log.Error("failed to close gRPC connection", logging.Err(err))
+	}
+}
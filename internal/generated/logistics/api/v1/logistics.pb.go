@@ -7,9 +7,13 @@
 package logistics_v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +25,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CargoUnitStatus
+type CargoUnitStatus int32
+
+const (
+	CargoUnitStatus_CARGO_UNIT_STATUS_UNSPECIFIED CargoUnitStatus = 0
+	CargoUnitStatus_CARGO_UNIT_STATUS_IN_TRANSIT  CargoUnitStatus = 1
+	CargoUnitStatus_CARGO_UNIT_STATUS_ARRIVED     CargoUnitStatus = 2
+)
+
+// Enum value maps for CargoUnitStatus.
+var (
+	CargoUnitStatus_name = map[int32]string{
+		0: "CARGO_UNIT_STATUS_UNSPECIFIED",
+		1: "CARGO_UNIT_STATUS_IN_TRANSIT",
+		2: "CARGO_UNIT_STATUS_ARRIVED",
+	}
+	CargoUnitStatus_value = map[string]int32{
+		"CARGO_UNIT_STATUS_UNSPECIFIED": 0,
+		"CARGO_UNIT_STATUS_IN_TRANSIT":  1,
+		"CARGO_UNIT_STATUS_ARRIVED":     2,
+	}
+)
+
+func (x CargoUnitStatus) Enum() *CargoUnitStatus {
+	p := new(CargoUnitStatus)
+	*p = x
+	return p
+}
+
+func (x CargoUnitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CargoUnitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_logistics_proto_enumTypes[0].Descriptor()
+}
+
+func (CargoUnitStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_logistics_proto_enumTypes[0]
+}
+
+func (x CargoUnitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CargoUnitStatus.Descriptor instead.
+func (CargoUnitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{0}
+}
+
+// MetricsGroupBy
+type MetricsGroupBy int32
+
+const (
+	MetricsGroupBy_METRICS_GROUP_BY_UNSPECIFIED MetricsGroupBy = 0
+	// hours and days start in UTC
+	MetricsGroupBy_METRICS_GROUP_BY_HOUR MetricsGroupBy = 1
+	MetricsGroupBy_METRICS_GROUP_BY_DAY  MetricsGroupBy = 2
+	// METRICS_GROUP_BY_WAREHOUSE counts arrivals only
+	MetricsGroupBy_METRICS_GROUP_BY_WAREHOUSE MetricsGroupBy = 3
+)
+
+// Enum value maps for MetricsGroupBy.
+var (
+	MetricsGroupBy_name = map[int32]string{
+		0: "METRICS_GROUP_BY_UNSPECIFIED",
+		1: "METRICS_GROUP_BY_HOUR",
+		2: "METRICS_GROUP_BY_DAY",
+		3: "METRICS_GROUP_BY_WAREHOUSE",
+	}
+	MetricsGroupBy_value = map[string]int32{
+		"METRICS_GROUP_BY_UNSPECIFIED": 0,
+		"METRICS_GROUP_BY_HOUR":        1,
+		"METRICS_GROUP_BY_DAY":         2,
+		"METRICS_GROUP_BY_WAREHOUSE":   3,
+	}
+)
+
+func (x MetricsGroupBy) Enum() *MetricsGroupBy {
+	p := new(MetricsGroupBy)
+	*p = x
+	return p
+}
+
+func (x MetricsGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_logistics_proto_enumTypes[1].Descriptor()
+}
+
+func (MetricsGroupBy) Type() protoreflect.EnumType {
+	return &file_api_v1_logistics_proto_enumTypes[1]
+}
+
+func (x MetricsGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsGroupBy.Descriptor instead.
+func (MetricsGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{1}
+}
+
 // MoveUnitRequest
 type MoveUnitRequest struct {
 	state         protoimpl.MessageState
@@ -29,6 +138,9 @@ type MoveUnitRequest struct {
 
 	CargoUnitId int64     `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
 	Location    *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// if_match is etag of the cargo unit the request is based on, the request is aborted if the unit has changed since,
+	// etag "0" requires the unit not to exist yet
+	IfMatch string `protobuf:"bytes,3,opt,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
 }
 
 func (x *MoveUnitRequest) Reset() {
@@ -77,6 +189,13 @@ func (x *MoveUnitRequest) GetLocation() *Location {
 	return nil
 }
 
+func (x *MoveUnitRequest) GetIfMatch() string {
+	if x != nil {
+		return x.IfMatch
+	}
+	return ""
+}
+
 // UnitReachedWarehouseRequest contains WarehouseAnnouncement with Location
 type UnitReachedWarehouseRequest struct {
 	state         protoimpl.MessageState
@@ -85,6 +204,9 @@ type UnitReachedWarehouseRequest struct {
 
 	Location     *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 	Announcement *WarehouseAnnouncement `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// if_match is etag of the cargo unit the request is based on, the request is aborted if the unit has changed since,
+	// etag "0" requires the unit not to exist yet
+	IfMatch string `protobuf:"bytes,3,opt,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
 }
 
 func (x *UnitReachedWarehouseRequest) Reset() {
@@ -133,15 +255,28 @@ func (x *UnitReachedWarehouseRequest) GetAnnouncement() *WarehouseAnnouncement {
 	return nil
 }
 
-// DefaultResponse
-type DefaultResponse struct {
+func (x *UnitReachedWarehouseRequest) GetIfMatch() string {
+	if x != nil {
+		return x.IfMatch
+	}
+	return ""
+}
+
+// UnitDepartedWarehouseRequest contains WarehouseAnnouncement of the warehouse the unit is staying at with Location
+type UnitDepartedWarehouseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Location     *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Announcement *WarehouseAnnouncement `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// if_match is etag of the cargo unit the request is based on, the request is aborted if the unit has changed since,
+	// etag "0" requires the unit not to exist yet
+	IfMatch string `protobuf:"bytes,3,opt,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
 }
 
-func (x *DefaultResponse) Reset() {
-	*x = DefaultResponse{}
+func (x *UnitDepartedWarehouseRequest) Reset() {
+	*x = UnitDepartedWarehouseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_logistics_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,13 +284,13 @@ func (x *DefaultResponse) Reset() {
 	}
 }
 
-func (x *DefaultResponse) String() string {
+func (x *UnitDepartedWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultResponse) ProtoMessage() {}
+func (*UnitDepartedWarehouseRequest) ProtoMessage() {}
 
-func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
+func (x *UnitDepartedWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_logistics_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,20 +302,44 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
-func (*DefaultResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnitDepartedWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UnitDepartedWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_logistics_proto_rawDescGZIP(), []int{2}
 }
 
-// DefaultRequest
-type DefaultRequest struct {
+func (x *UnitDepartedWarehouseRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UnitDepartedWarehouseRequest) GetAnnouncement() *WarehouseAnnouncement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *UnitDepartedWarehouseRequest) GetIfMatch() string {
+	if x != nil {
+		return x.IfMatch
+	}
+	return ""
+}
+
+// BatchMoveUnitsRequest
+type BatchMoveUnitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// requests are validated one by one, so an invalid request is reported in its BatchItemResult
+	Requests []*MoveUnitRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *DefaultRequest) Reset() {
-	*x = DefaultRequest{}
+func (x *BatchMoveUnitsRequest) Reset() {
+	*x = BatchMoveUnitsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_logistics_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -188,13 +347,13 @@ func (x *DefaultRequest) Reset() {
 	}
 }
 
-func (x *DefaultRequest) String() string {
+func (x *BatchMoveUnitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultRequest) ProtoMessage() {}
+func (*BatchMoveUnitsRequest) ProtoMessage() {}
 
-func (x *DefaultRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchMoveUnitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_logistics_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,22 +365,30 @@ func (x *DefaultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultRequest.ProtoReflect.Descriptor instead.
-func (*DefaultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchMoveUnitsRequest.ProtoReflect.Descriptor instead.
+func (*BatchMoveUnitsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_logistics_proto_rawDescGZIP(), []int{3}
 }
 
-type DeliveryUnitsWarehouseReceivedTotalNumber struct {
+func (x *BatchMoveUnitsRequest) GetRequests() []*MoveUnitRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// BatchUnitReachedWarehouseRequest
+type BatchUnitReachedWarehouseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WarehouseId         int64 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	DeliveryUnitsNumber int64 `protobuf:"varint,2,opt,name=delivery_units_number,json=deliveryUnitsNumber,proto3" json:"delivery_units_number,omitempty"`
+	// requests are validated one by one, so an invalid request is reported in its BatchItemResult
+	Requests []*UnitReachedWarehouseRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *DeliveryUnitsWarehouseReceivedTotalNumber) Reset() {
-	*x = DeliveryUnitsWarehouseReceivedTotalNumber{}
+func (x *BatchUnitReachedWarehouseRequest) Reset() {
+	*x = BatchUnitReachedWarehouseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_logistics_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,13 +396,13 @@ func (x *DeliveryUnitsWarehouseReceivedTotalNumber) Reset() {
 	}
 }
 
-func (x *DeliveryUnitsWarehouseReceivedTotalNumber) String() string {
+func (x *BatchUnitReachedWarehouseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliveryUnitsWarehouseReceivedTotalNumber) ProtoMessage() {}
+func (*BatchUnitReachedWarehouseRequest) ProtoMessage() {}
 
-func (x *DeliveryUnitsWarehouseReceivedTotalNumber) ProtoReflect() protoreflect.Message {
+func (x *BatchUnitReachedWarehouseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_logistics_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,39 +414,29 @@ func (x *DeliveryUnitsWarehouseReceivedTotalNumber) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliveryUnitsWarehouseReceivedTotalNumber.ProtoReflect.Descriptor instead.
-func (*DeliveryUnitsWarehouseReceivedTotalNumber) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUnitReachedWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*BatchUnitReachedWarehouseRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_logistics_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeliveryUnitsWarehouseReceivedTotalNumber) GetWarehouseId() int64 {
-	if x != nil {
-		return x.WarehouseId
-	}
-	return 0
-}
-
-func (x *DeliveryUnitsWarehouseReceivedTotalNumber) GetDeliveryUnitsNumber() int64 {
+func (x *BatchUnitReachedWarehouseRequest) GetRequests() []*UnitReachedWarehouseRequest {
 	if x != nil {
-		return x.DeliveryUnitsNumber
+		return x.Requests
 	}
-	return 0
+	return nil
 }
 
-// MetricsReport
-type MetricsReportResponse struct {
+// GetCargoUnitRequest
+type GetCargoUnitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeliveryUnitsNumber                           int64                                        `protobuf:"varint,1,opt,name=delivery_units_number,json=deliveryUnitsNumber,proto3" json:"delivery_units_number,omitempty"`
-	WarehousesReceivedSuppliesList                []int64                                      `protobuf:"varint,2,rep,packed,name=warehouses_received_supplies_list,json=warehousesReceivedSuppliesList,proto3" json:"warehouses_received_supplies_list,omitempty"`
-	DeliveryUnitsReachedDestination               []int64                                      `protobuf:"varint,3,rep,packed,name=delivery_units_reached_destination,json=deliveryUnitsReachedDestination,proto3" json:"delivery_units_reached_destination,omitempty"`
-	DeliveryUnitsEachWarehouseReceivedTotalNumber []*DeliveryUnitsWarehouseReceivedTotalNumber `protobuf:"bytes,4,rep,name=delivery_units_each_warehouse_received_total_number,json=deliveryUnitsEachWarehouseReceivedTotalNumber,proto3" json:"delivery_units_each_warehouse_received_total_number,omitempty"`
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
 }
 
-func (x *MetricsReportResponse) Reset() {
-	*x = MetricsReportResponse{}
+func (x *GetCargoUnitRequest) Reset() {
+	*x = GetCargoUnitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_logistics_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -287,13 +444,13 @@ func (x *MetricsReportResponse) Reset() {
 	}
 }
 
-func (x *MetricsReportResponse) String() string {
+func (x *GetCargoUnitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsReportResponse) ProtoMessage() {}
+func (*GetCargoUnitRequest) ProtoMessage() {}
 
-func (x *MetricsReportResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCargoUnitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_logistics_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,55 +462,29 @@ func (x *MetricsReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsReportResponse.ProtoReflect.Descriptor instead.
-func (*MetricsReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCargoUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_logistics_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MetricsReportResponse) GetDeliveryUnitsNumber() int64 {
+func (x *GetCargoUnitRequest) GetCargoUnitId() int64 {
 	if x != nil {
-		return x.DeliveryUnitsNumber
+		return x.CargoUnitId
 	}
 	return 0
 }
 
-func (x *MetricsReportResponse) GetWarehousesReceivedSuppliesList() []int64 {
-	if x != nil {
-		return x.WarehousesReceivedSuppliesList
-	}
-	return nil
-}
-
-func (x *MetricsReportResponse) GetDeliveryUnitsReachedDestination() []int64 {
-	if x != nil {
-		return x.DeliveryUnitsReachedDestination
-	}
-	return nil
-}
-
-func (x *MetricsReportResponse) GetDeliveryUnitsEachWarehouseReceivedTotalNumber() []*DeliveryUnitsWarehouseReceivedTotalNumber {
-	if x != nil {
-		return x.DeliveryUnitsEachWarehouseReceivedTotalNumber
-	}
-	return nil
-}
-
-// WarehouseAnnouncement
-type WarehouseAnnouncement struct {
+// GetCargoUnitTrackRequest
+type GetCargoUnitTrackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cargo_unit_id is unique id
 	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
-	// warehouse_id is unique id
-	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	// the message contains information about the announcement
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *WarehouseAnnouncement) Reset() {
-	*x = WarehouseAnnouncement{}
+func (x *GetCargoUnitTrackRequest) Reset() {
+	*x = GetCargoUnitTrackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_logistics_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,13 +492,13 @@ func (x *WarehouseAnnouncement) Reset() {
 	}
 }
 
-func (x *WarehouseAnnouncement) String() string {
+func (x *GetCargoUnitTrackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarehouseAnnouncement) ProtoMessage() {}
+func (*GetCargoUnitTrackRequest) ProtoMessage() {}
 
-func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
+func (x *GetCargoUnitTrackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_logistics_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,59 +510,98 @@ func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarehouseAnnouncement.ProtoReflect.Descriptor instead.
-func (*WarehouseAnnouncement) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCargoUnitTrackRequest.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitTrackRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_logistics_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *WarehouseAnnouncement) GetCargoUnitId() int64 {
+func (x *GetCargoUnitTrackRequest) GetCargoUnitId() int64 {
 	if x != nil {
 		return x.CargoUnitId
 	}
 	return 0
 }
 
-func (x *WarehouseAnnouncement) GetWarehouseId() int64 {
-	if x != nil {
-		return x.WarehouseId
+// GetCargoUnitStatsRequest
+type GetCargoUnitStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+}
+
+func (x *GetCargoUnitStatsRequest) Reset() {
+	*x = GetCargoUnitStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WarehouseAnnouncement) GetMessage() string {
+func (x *GetCargoUnitStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCargoUnitStatsRequest) ProtoMessage() {}
+
+func (x *GetCargoUnitStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCargoUnitStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCargoUnitStatsRequest) GetCargoUnitId() int64 {
 	if x != nil {
-		return x.Message
+		return x.CargoUnitId
 	}
-	return ""
+	return 0
 }
 
-// Location where entity now located in X,Y Axis
-type Location struct {
+// ListCargoUnitsRequest contains page parameters and filters, unset filters match every unit
+type ListCargoUnitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Latitude  uint32 `protobuf:"varint,1,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
-	Longitude uint32 `protobuf:"varint,2,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	// page_size is a maximum number of units to return, defaults to 50 and capped at 1000
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is next_page_token of the previous response
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Status        CargoUnitStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=logistics.api.v1.CargoUnitStatus" json:"status,omitempty"`
+	LastSeenAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_after,json=lastSeenAfter,proto3" json:"last_seen_after,omitempty"`
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
+func (x *ListCargoUnitsRequest) Reset() {
+	*x = ListCargoUnitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_logistics_proto_msgTypes[7]
+		mi := &file_api_v1_logistics_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Location) String() string {
+func (x *ListCargoUnitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*ListCargoUnitsRequest) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_logistics_proto_msgTypes[7]
+func (x *ListCargoUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,132 +612,2604 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_v1_logistics_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListCargoUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ListCargoUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Location) GetLatitude() uint32 {
+func (x *ListCargoUnitsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Latitude
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *Location) GetLongitude() uint32 {
+func (x *ListCargoUnitsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Longitude
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCargoUnitsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
 	}
 	return 0
 }
 
-var File_api_v1_logistics_proto protoreflect.FileDescriptor
+func (x *ListCargoUnitsRequest) GetStatus() CargoUnitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CargoUnitStatus_CARGO_UNIT_STATUS_UNSPECIFIED
+}
 
-var file_api_v1_logistics_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65,
-	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63,
-	0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12,
-	0x36, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x1b, 0x55, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4b, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
-	0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c,
-	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x82, 0x01, 0x0a, 0x29, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75,
-	0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8d, 0x03, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69,
-	0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x21, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
-	0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x1e, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x4b, 0x0a, 0x22, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x1f, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa7, 0x01, 0x0a,
-	0x33, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f,
-	0x65, 0x61, 0x63, 0x68, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6c, 0x6f, 0x67,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x57, 0x61, 0x72, 0x65, 0x68,
-	0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x45, 0x61, 0x63, 0x68, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f,
-	0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x78, 0x0a, 0x15, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f,
-	0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69,
-	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68,
-	0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x44, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x6f, 0x6e,
-	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x32, 0x88, 0x03, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x41, 0x50, 0x49, 0x12, 0x6d, 0x0a,
-	0x08, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
-	0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c,
-	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72,
-	0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x92, 0x01, 0x0a,
-	0x14, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65,
-	0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x2d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22,
-	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63,
-	0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x42, 0x32, 0x5a, 0x30, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListCargoUnitsRequest) GetLastSeenAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAfter
+	}
+	return nil
 }
 
-var (
-	file_api_v1_logistics_proto_rawDescOnce sync.Once
-	file_api_v1_logistics_proto_rawDescData = file_api_v1_logistics_proto_rawDesc
-)
+// ListCargoUnitEventsRequest
+type ListCargoUnitEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// page_size is a maximum number of events to return, defaults to 50 and capped at 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is next_page_token of the previous response
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListCargoUnitEventsRequest) Reset() {
+	*x = ListCargoUnitEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCargoUnitEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCargoUnitEventsRequest) ProtoMessage() {}
+
+func (x *ListCargoUnitEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCargoUnitEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCargoUnitEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCargoUnitEventsRequest) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *ListCargoUnitEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCargoUnitEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// WatchCargoUnitsRequest contains filters, unset filters match every event
+type WatchCargoUnitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitIds []int64 `protobuf:"varint,1,rep,packed,name=cargo_unit_ids,json=cargoUnitIds,proto3" json:"cargo_unit_ids,omitempty"`
+	// warehouse_id matches only events of units reaching the warehouse
+	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+}
+
+func (x *WatchCargoUnitsRequest) Reset() {
+	*x = WatchCargoUnitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCargoUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCargoUnitsRequest) ProtoMessage() {}
+
+func (x *WatchCargoUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCargoUnitsRequest.ProtoReflect.Descriptor instead.
+func (*WatchCargoUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchCargoUnitsRequest) GetCargoUnitIds() []int64 {
+	if x != nil {
+		return x.CargoUnitIds
+	}
+	return nil
+}
+
+func (x *WatchCargoUnitsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// MetricsReportRequest contains filters and grouping of the series, totals of the report are not filtered
+type MetricsReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from and to limit the series to events occurred in [from, to), unset bounds are open
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// warehouse_ids limits the series to arrivals at the warehouses
+	WarehouseIds []int64 `protobuf:"varint,3,rep,packed,name=warehouse_ids,json=warehouseIds,proto3" json:"warehouse_ids,omitempty"`
+	// group_by groups the series into buckets, no series is returned unless it is set and from, to and warehouse_ids are rejected without it
+	GroupBy MetricsGroupBy `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=logistics.api.v1.MetricsGroupBy" json:"group_by,omitempty"`
+}
+
+func (x *MetricsReportRequest) Reset() {
+	*x = MetricsReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsReportRequest) ProtoMessage() {}
+
+func (x *MetricsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsReportRequest.ProtoReflect.Descriptor instead.
+func (*MetricsReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MetricsReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *MetricsReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *MetricsReportRequest) GetWarehouseIds() []int64 {
+	if x != nil {
+		return x.WarehouseIds
+	}
+	return nil
+}
+
+func (x *MetricsReportRequest) GetGroupBy() MetricsGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return MetricsGroupBy_METRICS_GROUP_BY_UNSPECIFIED
+}
+
+// WarehouseStatsRequest contains the time window, to defaults to now and from to 24 hours before to
+type WarehouseStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// warehouse_id limits stats to the warehouse, stats of every warehouse with units passing through are returned if it is not set
+	WarehouseId int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *WarehouseStatsRequest) Reset() {
+	*x = WarehouseStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStatsRequest) ProtoMessage() {}
+
+func (x *WarehouseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStatsRequest.ProtoReflect.Descriptor instead.
+func (*WarehouseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WarehouseStatsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *WarehouseStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// DefaultResponse
+type DefaultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DefaultResponse) Reset() {
+	*x = DefaultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultResponse) ProtoMessage() {}
+
+func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
+func (*DefaultResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{13}
+}
+
+// DefaultRequest
+type DefaultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DefaultRequest) Reset() {
+	*x = DefaultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultRequest) ProtoMessage() {}
+
+func (x *DefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultRequest.ProtoReflect.Descriptor instead.
+func (*DefaultRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{14}
+}
+
+type DeliveryUnitsWarehouseReceivedTotalNumber struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WarehouseId         int64 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	DeliveryUnitsNumber int64 `protobuf:"varint,2,opt,name=delivery_units_number,json=deliveryUnitsNumber,proto3" json:"delivery_units_number,omitempty"`
+}
+
+func (x *DeliveryUnitsWarehouseReceivedTotalNumber) Reset() {
+	*x = DeliveryUnitsWarehouseReceivedTotalNumber{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryUnitsWarehouseReceivedTotalNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryUnitsWarehouseReceivedTotalNumber) ProtoMessage() {}
+
+func (x *DeliveryUnitsWarehouseReceivedTotalNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryUnitsWarehouseReceivedTotalNumber.ProtoReflect.Descriptor instead.
+func (*DeliveryUnitsWarehouseReceivedTotalNumber) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeliveryUnitsWarehouseReceivedTotalNumber) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *DeliveryUnitsWarehouseReceivedTotalNumber) GetDeliveryUnitsNumber() int64 {
+	if x != nil {
+		return x.DeliveryUnitsNumber
+	}
+	return 0
+}
+
+// StreamMoveUnitsResponse contains numbers of applied and rejected unit moves
+type StreamMoveUnitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptedNumber int64 `protobuf:"varint,1,opt,name=accepted_number,json=acceptedNumber,proto3" json:"accepted_number,omitempty"`
+	RejectedNumber int64 `protobuf:"varint,2,opt,name=rejected_number,json=rejectedNumber,proto3" json:"rejected_number,omitempty"`
+	// rejected lists results of the first 100 rejected unit moves, index is the position of the move in the stream
+	Rejected []*BatchItemResult `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *StreamMoveUnitsResponse) Reset() {
+	*x = StreamMoveUnitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMoveUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMoveUnitsResponse) ProtoMessage() {}
+
+func (x *StreamMoveUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMoveUnitsResponse.ProtoReflect.Descriptor instead.
+func (*StreamMoveUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StreamMoveUnitsResponse) GetAcceptedNumber() int64 {
+	if x != nil {
+		return x.AcceptedNumber
+	}
+	return 0
+}
+
+func (x *StreamMoveUnitsResponse) GetRejectedNumber() int64 {
+	if x != nil {
+		return x.RejectedNumber
+	}
+	return 0
+}
+
+func (x *StreamMoveUnitsResponse) GetRejected() []*BatchItemResult {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+// BatchResponse contains result of every request of the batch in the order they were sent
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results        []*BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AcceptedNumber int64              `protobuf:"varint,2,opt,name=accepted_number,json=acceptedNumber,proto3" json:"accepted_number,omitempty"`
+	RejectedNumber int64              `protobuf:"varint,3,opt,name=rejected_number,json=rejectedNumber,proto3" json:"rejected_number,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchResponse) GetAcceptedNumber() int64 {
+	if x != nil {
+		return x.AcceptedNumber
+	}
+	return 0
+}
+
+func (x *BatchResponse) GetRejectedNumber() int64 {
+	if x != nil {
+		return x.RejectedNumber
+	}
+	return 0
+}
+
+// BatchItemResult
+type BatchItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index of the request in the batch
+	Index       int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	CargoUnitId int64 `protobuf:"varint,2,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// status code is OK when the request was applied
+	Status *status.Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// GetCargoUnitResponse
+type GetCargoUnitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnit *CargoUnit `protobuf:"bytes,1,opt,name=cargo_unit,json=cargoUnit,proto3" json:"cargo_unit,omitempty"`
+}
+
+func (x *GetCargoUnitResponse) Reset() {
+	*x = GetCargoUnitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCargoUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCargoUnitResponse) ProtoMessage() {}
+
+func (x *GetCargoUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCargoUnitResponse.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCargoUnitResponse) GetCargoUnit() *CargoUnit {
+	if x != nil {
+		return x.CargoUnit
+	}
+	return nil
+}
+
+// GetCargoUnitTrackResponse contains locations in the order they were reported
+type GetCargoUnitTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId  int64       `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	Track        []*Location `protobuf:"bytes,2,rep,name=track,proto3" json:"track,omitempty"`
+	LastLocation *Location   `protobuf:"bytes,3,opt,name=last_location,json=lastLocation,proto3" json:"last_location,omitempty"`
+	// warehouse_arrival is the last warehouse arrival, it is not set until unit reached warehouse
+	WarehouseArrival *WarehouseArrival `protobuf:"bytes,4,opt,name=warehouse_arrival,json=warehouseArrival,proto3" json:"warehouse_arrival,omitempty"`
+	// etag changes every time the cargo unit changes
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// warehouse_visits lists every warehouse the unit has reached in the order of arrival
+	WarehouseVisits []*WarehouseVisit `protobuf:"bytes,6,rep,name=warehouse_visits,json=warehouseVisits,proto3" json:"warehouse_visits,omitempty"`
+}
+
+func (x *GetCargoUnitTrackResponse) Reset() {
+	*x = GetCargoUnitTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCargoUnitTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCargoUnitTrackResponse) ProtoMessage() {}
+
+func (x *GetCargoUnitTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCargoUnitTrackResponse.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitTrackResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCargoUnitTrackResponse) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *GetCargoUnitTrackResponse) GetTrack() []*Location {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *GetCargoUnitTrackResponse) GetLastLocation() *Location {
+	if x != nil {
+		return x.LastLocation
+	}
+	return nil
+}
+
+func (x *GetCargoUnitTrackResponse) GetWarehouseArrival() *WarehouseArrival {
+	if x != nil {
+		return x.WarehouseArrival
+	}
+	return nil
+}
+
+func (x *GetCargoUnitTrackResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetCargoUnitTrackResponse) GetWarehouseVisits() []*WarehouseVisit {
+	if x != nil {
+		return x.WarehouseVisits
+	}
+	return nil
+}
+
+// GetCargoUnitStatsResponse
+type GetCargoUnitStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId int64        `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	Stats       *TravelStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetCargoUnitStatsResponse) Reset() {
+	*x = GetCargoUnitStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCargoUnitStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCargoUnitStatsResponse) ProtoMessage() {}
+
+func (x *GetCargoUnitStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCargoUnitStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCargoUnitStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCargoUnitStatsResponse) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *GetCargoUnitStatsResponse) GetStats() *TravelStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ListCargoUnitsResponse
+type ListCargoUnitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnits []*CargoUnit `protobuf:"bytes,1,rep,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
+	// next_page_token is empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCargoUnitsResponse) Reset() {
+	*x = ListCargoUnitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCargoUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCargoUnitsResponse) ProtoMessage() {}
+
+func (x *ListCargoUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCargoUnitsResponse.ProtoReflect.Descriptor instead.
+func (*ListCargoUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCargoUnitsResponse) GetCargoUnits() []*CargoUnit {
+	if x != nil {
+		return x.CargoUnits
+	}
+	return nil
+}
+
+func (x *ListCargoUnitsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListCargoUnitEventsResponse
+type ListCargoUnitEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*CargoUnitEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// next_page_token is empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCargoUnitEventsResponse) Reset() {
+	*x = ListCargoUnitEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCargoUnitEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCargoUnitEventsResponse) ProtoMessage() {}
+
+func (x *ListCargoUnitEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCargoUnitEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCargoUnitEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListCargoUnitEventsResponse) GetEvents() []*CargoUnitEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListCargoUnitEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CargoUnitEvent
+type CargoUnitEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// occurred_at is the time reported by the device, or received_at if the device did not report it
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are assignable to Event:
+	//	*CargoUnitEvent_UnitMoved
+	//	*CargoUnitEvent_UnitReachedWarehouse
+	//	*CargoUnitEvent_UnitDepartedWarehouse
+	Event isCargoUnitEvent_Event `protobuf_oneof:"event"`
+	// sequence orders events of the unit, it is the cargo unit etag right after the event
+	Sequence int64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// received_at is the time the server received the event
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *CargoUnitEvent) Reset() {
+	*x = CargoUnitEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CargoUnitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CargoUnitEvent) ProtoMessage() {}
+
+func (x *CargoUnitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CargoUnitEvent.ProtoReflect.Descriptor instead.
+func (*CargoUnitEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CargoUnitEvent) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *CargoUnitEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (m *CargoUnitEvent) GetEvent() isCargoUnitEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *CargoUnitEvent) GetUnitMoved() *UnitMoved {
+	if x, ok := x.GetEvent().(*CargoUnitEvent_UnitMoved); ok {
+		return x.UnitMoved
+	}
+	return nil
+}
+
+func (x *CargoUnitEvent) GetUnitReachedWarehouse() *WarehouseArrival {
+	if x, ok := x.GetEvent().(*CargoUnitEvent_UnitReachedWarehouse); ok {
+		return x.UnitReachedWarehouse
+	}
+	return nil
+}
+
+func (x *CargoUnitEvent) GetUnitDepartedWarehouse() *WarehouseDeparture {
+	if x, ok := x.GetEvent().(*CargoUnitEvent_UnitDepartedWarehouse); ok {
+		return x.UnitDepartedWarehouse
+	}
+	return nil
+}
+
+func (x *CargoUnitEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CargoUnitEvent) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type isCargoUnitEvent_Event interface {
+	isCargoUnitEvent_Event()
+}
+
+type CargoUnitEvent_UnitMoved struct {
+	UnitMoved *UnitMoved `protobuf:"bytes,3,opt,name=unit_moved,json=unitMoved,proto3,oneof"`
+}
+
+type CargoUnitEvent_UnitReachedWarehouse struct {
+	UnitReachedWarehouse *WarehouseArrival `protobuf:"bytes,4,opt,name=unit_reached_warehouse,json=unitReachedWarehouse,proto3,oneof"`
+}
+
+type CargoUnitEvent_UnitDepartedWarehouse struct {
+	UnitDepartedWarehouse *WarehouseDeparture `protobuf:"bytes,7,opt,name=unit_departed_warehouse,json=unitDepartedWarehouse,proto3,oneof"`
+}
+
+func (*CargoUnitEvent_UnitMoved) isCargoUnitEvent_Event() {}
+
+func (*CargoUnitEvent_UnitReachedWarehouse) isCargoUnitEvent_Event() {}
+
+func (*CargoUnitEvent_UnitDepartedWarehouse) isCargoUnitEvent_Event() {}
+
+// UnitMoved contains the new Location of the unit
+type UnitMoved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *Location `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *UnitMoved) Reset() {
+	*x = UnitMoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnitMoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitMoved) ProtoMessage() {}
+
+func (x *UnitMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitMoved.ProtoReflect.Descriptor instead.
+func (*UnitMoved) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnitMoved) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// MetricsReport
+type MetricsReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryUnitsNumber int64 `protobuf:"varint,1,opt,name=delivery_units_number,json=deliveryUnitsNumber,proto3" json:"delivery_units_number,omitempty"`
+	// warehouses_received_supplies_list lists every warehouse visited by any unit, ordered by id
+	WarehousesReceivedSuppliesList []int64 `protobuf:"varint,2,rep,packed,name=warehouses_received_supplies_list,json=warehousesReceivedSuppliesList,proto3" json:"warehouses_received_supplies_list,omitempty"`
+	// delivery_units_reached_destination lists units that have reached a warehouse, ordered by id
+	DeliveryUnitsReachedDestination []int64 `protobuf:"varint,3,rep,packed,name=delivery_units_reached_destination,json=deliveryUnitsReachedDestination,proto3" json:"delivery_units_reached_destination,omitempty"`
+	// delivery_units_each_warehouse_received_total_number counts every visit, a unit visiting a warehouse twice is counted twice.
+	// Units in transit have not visited any warehouse, they are counted in in_transit_units_number only
+	DeliveryUnitsEachWarehouseReceivedTotalNumber []*DeliveryUnitsWarehouseReceivedTotalNumber `protobuf:"bytes,4,rep,name=delivery_units_each_warehouse_received_total_number,json=deliveryUnitsEachWarehouseReceivedTotalNumber,proto3" json:"delivery_units_each_warehouse_received_total_number,omitempty"`
+	// travel_stats adds up tracks of every unit, speeds are averaged over the time of every unit and max speed is the highest of them
+	TravelStats *TravelStats `protobuf:"bytes,5,opt,name=travel_stats,json=travelStats,proto3" json:"travel_stats,omitempty"`
+	// in_transit_units lists units that have not reached any warehouse yet, ordered by id
+	InTransitUnits                        []int64 `protobuf:"varint,6,rep,packed,name=in_transit_units,json=inTransitUnits,proto3" json:"in_transit_units,omitempty"`
+	InTransitUnitsNumber                  int64   `protobuf:"varint,7,opt,name=in_transit_units_number,json=inTransitUnitsNumber,proto3" json:"in_transit_units_number,omitempty"`
+	DeliveryUnitsReachedDestinationNumber int64   `protobuf:"varint,8,opt,name=delivery_units_reached_destination_number,json=deliveryUnitsReachedDestinationNumber,proto3" json:"delivery_units_reached_destination_number,omitempty"`
+	// series lists buckets with events matching the request, ordered by start or warehouse
+	Series []*MetricsBucket `protobuf:"bytes,9,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *MetricsReportResponse) Reset() {
+	*x = MetricsReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsReportResponse) ProtoMessage() {}
+
+func (x *MetricsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsReportResponse.ProtoReflect.Descriptor instead.
+func (*MetricsReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MetricsReportResponse) GetDeliveryUnitsNumber() int64 {
+	if x != nil {
+		return x.DeliveryUnitsNumber
+	}
+	return 0
+}
+
+func (x *MetricsReportResponse) GetWarehousesReceivedSuppliesList() []int64 {
+	if x != nil {
+		return x.WarehousesReceivedSuppliesList
+	}
+	return nil
+}
+
+func (x *MetricsReportResponse) GetDeliveryUnitsReachedDestination() []int64 {
+	if x != nil {
+		return x.DeliveryUnitsReachedDestination
+	}
+	return nil
+}
+
+func (x *MetricsReportResponse) GetDeliveryUnitsEachWarehouseReceivedTotalNumber() []*DeliveryUnitsWarehouseReceivedTotalNumber {
+	if x != nil {
+		return x.DeliveryUnitsEachWarehouseReceivedTotalNumber
+	}
+	return nil
+}
+
+func (x *MetricsReportResponse) GetTravelStats() *TravelStats {
+	if x != nil {
+		return x.TravelStats
+	}
+	return nil
+}
+
+func (x *MetricsReportResponse) GetInTransitUnits() []int64 {
+	if x != nil {
+		return x.InTransitUnits
+	}
+	return nil
+}
+
+func (x *MetricsReportResponse) GetInTransitUnitsNumber() int64 {
+	if x != nil {
+		return x.InTransitUnitsNumber
+	}
+	return 0
+}
+
+func (x *MetricsReportResponse) GetDeliveryUnitsReachedDestinationNumber() int64 {
+	if x != nil {
+		return x.DeliveryUnitsReachedDestinationNumber
+	}
+	return 0
+}
+
+func (x *MetricsReportResponse) GetSeries() []*MetricsBucket {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// WarehouseStatsResponse contains stats of the time window [from, to)
+type WarehouseStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// warehouses are ordered by id
+	Warehouses []*WarehouseStats `protobuf:"bytes,3,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+}
+
+func (x *WarehouseStatsResponse) Reset() {
+	*x = WarehouseStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStatsResponse) ProtoMessage() {}
+
+func (x *WarehouseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStatsResponse.ProtoReflect.Descriptor instead.
+func (*WarehouseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WarehouseStatsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *WarehouseStatsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *WarehouseStatsResponse) GetWarehouses() []*WarehouseStats {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// WarehouseAnnouncement
+type WarehouseAnnouncement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cargo_unit_id is unique id
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// warehouse_id is unique id
+	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// the message contains information about the announcement
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WarehouseAnnouncement) Reset() {
+	*x = WarehouseAnnouncement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseAnnouncement) ProtoMessage() {}
+
+func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseAnnouncement.ProtoReflect.Descriptor instead.
+func (*WarehouseAnnouncement) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WarehouseAnnouncement) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *WarehouseAnnouncement) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseAnnouncement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CargoUnit is a current state of the cargo unit
+type CargoUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnitId  int64     `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	LastLocation *Location `protobuf:"bytes,2,opt,name=last_location,json=lastLocation,proto3" json:"last_location,omitempty"`
+	// locations_number is a number of locations in the unit track
+	LocationsNumber int64 `protobuf:"varint,3,opt,name=locations_number,json=locationsNumber,proto3" json:"locations_number,omitempty"`
+	// warehouse_arrival is not set until unit reached warehouse
+	WarehouseArrival *WarehouseArrival `protobuf:"bytes,4,opt,name=warehouse_arrival,json=warehouseArrival,proto3" json:"warehouse_arrival,omitempty"`
+	// last_seen_at is the time the last event of the unit was received
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// etag changes every time the cargo unit changes
+	Etag string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	// warehouse_visits_number is a number of warehouse visits of the unit
+	WarehouseVisitsNumber int64 `protobuf:"varint,7,opt,name=warehouse_visits_number,json=warehouseVisitsNumber,proto3" json:"warehouse_visits_number,omitempty"`
+}
+
+func (x *CargoUnit) Reset() {
+	*x = CargoUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CargoUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CargoUnit) ProtoMessage() {}
+
+func (x *CargoUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CargoUnit.ProtoReflect.Descriptor instead.
+func (*CargoUnit) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CargoUnit) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *CargoUnit) GetLastLocation() *Location {
+	if x != nil {
+		return x.LastLocation
+	}
+	return nil
+}
+
+func (x *CargoUnit) GetLocationsNumber() int64 {
+	if x != nil {
+		return x.LocationsNumber
+	}
+	return 0
+}
+
+func (x *CargoUnit) GetWarehouseArrival() *WarehouseArrival {
+	if x != nil {
+		return x.WarehouseArrival
+	}
+	return nil
+}
+
+func (x *CargoUnit) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *CargoUnit) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CargoUnit) GetWarehouseVisitsNumber() int64 {
+	if x != nil {
+		return x.WarehouseVisitsNumber
+	}
+	return 0
+}
+
+// MetricsBucket counts events of an hour, a day or a warehouse
+type MetricsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start is not set if the series is grouped by warehouse
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// warehouse_id is not set unless the series is grouped by warehouse
+	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Arrivals    int64 `protobuf:"varint,3,opt,name=arrivals,proto3" json:"arrivals,omitempty"`
+	// active_units counts units with events in the bucket, every unit is counted once
+	ActiveUnits int64 `protobuf:"varint,4,opt,name=active_units,json=activeUnits,proto3" json:"active_units,omitempty"`
+}
+
+func (x *MetricsBucket) Reset() {
+	*x = MetricsBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsBucket) ProtoMessage() {}
+
+func (x *MetricsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsBucket.ProtoReflect.Descriptor instead.
+func (*MetricsBucket) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MetricsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *MetricsBucket) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetArrivals() int64 {
+	if x != nil {
+		return x.Arrivals
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetActiveUnits() int64 {
+	if x != nil {
+		return x.ActiveUnits
+	}
+	return 0
+}
+
+// TravelStats describes the path travelled along the track, distances are great-circle distances between consecutive locations
+type TravelStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// distance_meters is the length of the track
+	DistanceMeters float64 `protobuf:"fixed64,1,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	// straight_line_meters is the distance between the first and the last location of the track
+	StraightLineMeters float64 `protobuf:"fixed64,2,opt,name=straight_line_meters,json=straightLineMeters,proto3" json:"straight_line_meters,omitempty"`
+	// path_ratio is distance_meters divided by straight_line_meters, 0 if the track ends where it starts
+	PathRatio float64 `protobuf:"fixed64,3,opt,name=path_ratio,json=pathRatio,proto3" json:"path_ratio,omitempty"`
+	// average_speed and max_speed are in meters per second, they are computed from the segments of the track between locations
+	// of increasing time only, and are 0 until there are such segments
+	AverageSpeed float64 `protobuf:"fixed64,4,opt,name=average_speed,json=averageSpeed,proto3" json:"average_speed,omitempty"`
+	MaxSpeed     float64 `protobuf:"fixed64,5,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+}
+
+func (x *TravelStats) Reset() {
+	*x = TravelStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelStats) ProtoMessage() {}
+
+func (x *TravelStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelStats.ProtoReflect.Descriptor instead.
+func (*TravelStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TravelStats) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *TravelStats) GetStraightLineMeters() float64 {
+	if x != nil {
+		return x.StraightLineMeters
+	}
+	return 0
+}
+
+func (x *TravelStats) GetPathRatio() float64 {
+	if x != nil {
+		return x.PathRatio
+	}
+	return 0
+}
+
+func (x *TravelStats) GetAverageSpeed() float64 {
+	if x != nil {
+		return x.AverageSpeed
+	}
+	return 0
+}
+
+func (x *TravelStats) GetMaxSpeed() float64 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+// WarehouseArrival contains WarehouseAnnouncement with Location
+type WarehouseArrival struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location     *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Announcement *WarehouseAnnouncement `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+}
+
+func (x *WarehouseArrival) Reset() {
+	*x = WarehouseArrival{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseArrival) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseArrival) ProtoMessage() {}
+
+func (x *WarehouseArrival) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseArrival.ProtoReflect.Descriptor instead.
+func (*WarehouseArrival) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WarehouseArrival) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WarehouseArrival) GetAnnouncement() *WarehouseAnnouncement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// WarehouseDeparture contains WarehouseAnnouncement of the warehouse the unit left with Location
+type WarehouseDeparture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location     *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Announcement *WarehouseAnnouncement `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+}
+
+func (x *WarehouseDeparture) Reset() {
+	*x = WarehouseDeparture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseDeparture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseDeparture) ProtoMessage() {}
+
+func (x *WarehouseDeparture) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseDeparture.ProtoReflect.Descriptor instead.
+func (*WarehouseDeparture) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WarehouseDeparture) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WarehouseDeparture) GetAnnouncement() *WarehouseAnnouncement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+// WarehouseStats describes units passing through the warehouse in the time window
+type WarehouseStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WarehouseId int64 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// dwell percentiles are times units stayed at the warehouse, of the visits ended in the window
+	DwellP50 *durationpb.Duration `protobuf:"bytes,2,opt,name=dwell_p50,json=dwellP50,proto3" json:"dwell_p50,omitempty"`
+	DwellP90 *durationpb.Duration `protobuf:"bytes,3,opt,name=dwell_p90,json=dwellP90,proto3" json:"dwell_p90,omitempty"`
+	DwellP99 *durationpb.Duration `protobuf:"bytes,4,opt,name=dwell_p99,json=dwellP99,proto3" json:"dwell_p99,omitempty"`
+	// inbound_units counts arrivals in the window, outbound_units counts departures
+	InboundUnits    int64   `protobuf:"varint,5,opt,name=inbound_units,json=inboundUnits,proto3" json:"inbound_units,omitempty"`
+	OutboundUnits   int64   `protobuf:"varint,6,opt,name=outbound_units,json=outboundUnits,proto3" json:"outbound_units,omitempty"`
+	InboundPerHour  float64 `protobuf:"fixed64,7,opt,name=inbound_per_hour,json=inboundPerHour,proto3" json:"inbound_per_hour,omitempty"`
+	OutboundPerHour float64 `protobuf:"fixed64,8,opt,name=outbound_per_hour,json=outboundPerHour,proto3" json:"outbound_per_hour,omitempty"`
+	// occupancy is a number of units staying at the warehouse at the end of the window
+	Occupancy int64 `protobuf:"varint,9,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+}
+
+func (x *WarehouseStats) Reset() {
+	*x = WarehouseStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStats) ProtoMessage() {}
+
+func (x *WarehouseStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStats.ProtoReflect.Descriptor instead.
+func (*WarehouseStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WarehouseStats) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseStats) GetDwellP50() *durationpb.Duration {
+	if x != nil {
+		return x.DwellP50
+	}
+	return nil
+}
+
+func (x *WarehouseStats) GetDwellP90() *durationpb.Duration {
+	if x != nil {
+		return x.DwellP90
+	}
+	return nil
+}
+
+func (x *WarehouseStats) GetDwellP99() *durationpb.Duration {
+	if x != nil {
+		return x.DwellP99
+	}
+	return nil
+}
+
+func (x *WarehouseStats) GetInboundUnits() int64 {
+	if x != nil {
+		return x.InboundUnits
+	}
+	return 0
+}
+
+func (x *WarehouseStats) GetOutboundUnits() int64 {
+	if x != nil {
+		return x.OutboundUnits
+	}
+	return 0
+}
+
+func (x *WarehouseStats) GetInboundPerHour() float64 {
+	if x != nil {
+		return x.InboundPerHour
+	}
+	return 0
+}
+
+func (x *WarehouseStats) GetOutboundPerHour() float64 {
+	if x != nil {
+		return x.OutboundPerHour
+	}
+	return 0
+}
+
+func (x *WarehouseStats) GetOccupancy() int64 {
+	if x != nil {
+		return x.Occupancy
+	}
+	return 0
+}
+
+// WarehouseVisit is a stay of the cargo unit at a warehouse
+type WarehouseVisit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arrival   *WarehouseArrival      `protobuf:"bytes,1,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	ArrivedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	// departed_at is not set while the unit stays at the warehouse, the unit departs
+	// once it is reported departing, moving or reaching another warehouse
+	DepartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departed_at,json=departedAt,proto3" json:"departed_at,omitempty"`
+}
+
+func (x *WarehouseVisit) Reset() {
+	*x = WarehouseVisit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseVisit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseVisit) ProtoMessage() {}
+
+func (x *WarehouseVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseVisit.ProtoReflect.Descriptor instead.
+func (*WarehouseVisit) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WarehouseVisit) GetArrival() *WarehouseArrival {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *WarehouseVisit) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *WarehouseVisit) GetDepartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartedAt
+	}
+	return nil
+}
+
+// Location where entity now located in X,Y Axis, in whole degrees. Locations reported by v2 are rounded to whole degrees,
+// degrees of the southern and western hemispheres are reported as 0, v2 reports them as they are
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  uint32 `protobuf:"varint,1,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
+	Longitude uint32 `protobuf:"varint,2,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	// recorded_at is the time the device was at the location, tracks are ordered by it, optional
+	RecordedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	// received_at is the time the server received the location, it is set by the server and ignored in requests
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_logistics_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_logistics_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_v1_logistics_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Location) GetLatitude() uint32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() uint32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+func (x *Location) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+var File_api_v1_logistics_proto protoreflect.FileDescriptor
+
+var file_api_v1_logistics_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x0f,
+	0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x08, 0x69, 0x66, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0xda, 0x01, 0x0a, 0x1b, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6f, 0x67,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x69,
+	0x66, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0xdb, 0x01, 0x0a, 0x1c, 0x55, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x69, 0x66, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x18, 0x20, 0x52, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x6a, 0x0a,
+	0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x12, 0xfa, 0x42, 0x0f,
+	0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x20, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5d,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x8a, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x42, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x22, 0x47, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63,
+	0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69,
+	0x74, 0x49, 0x64, 0x22, 0x9d, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x18, 0x40, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a,
+	0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x67,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x7d, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0e, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x10, 0xe8, 0x07, 0x18, 0x01, 0x22,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x22,
+	0xf0, 0x01, 0x0a, 0x14, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x35, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d,
+	0x92, 0x01, 0x0a, 0x10, 0x64, 0x18, 0x01, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0c,
+	0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x77, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x29, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x57, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xaa,
+	0x01, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x0f,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0a, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x09,
+	0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x3f, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f,
+	0x0a, 0x11, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x10, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x12, 0x4b, 0x0a, 0x10, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52,
+	0x0f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x22, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcd, 0x03, 0x0a, 0x0e, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09,
+	0x75, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x16, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x48, 0x00, 0x52,
+	0x14, 0x75, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x17, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15,
+	0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x74, 0x4d,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x05, 0x0a,
+	0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x21, 0x77, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x1e, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x22, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x1f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0xa7, 0x01, 0x0a, 0x33, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x65, 0x61, 0x63, 0x68, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x2d, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x45, 0x61, 0x63, 0x68,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x6e, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x69, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x58, 0x0a, 0x29, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x25, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x40, 0x0a, 0x0a, 0x77, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0a, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x15,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f,
+	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x4f, 0x0a, 0x11, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f,
+	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52,
+	0x10, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67,
+	0x68, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0x97, 0x01,
+	0x0a, 0x10, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x9b, 0x03, 0x0a, 0x0e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x77, 0x65,
+	0x6c, 0x6c, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x50, 0x35,
+	0x30, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x39, 0x30, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x50, 0x39, 0x30, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x77, 0x65,
+	0x6c, 0x6c, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x50, 0x39,
+	0x39, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x48,
+	0x6f, 0x75, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x22, 0xc6, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x61, 0x72, 0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a,
+	0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02,
+	0x18, 0x5a, 0x52, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x09,
+	0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xb4, 0x01, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x75,
+	0x0a, 0x0f, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x52, 0x47, 0x4f, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x41, 0x52, 0x47, 0x4f, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41, 0x52, 0x47, 0x4f, 0x5f,
+	0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x49,
+	0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54, 0x52,
+	0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x48,
+	0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x42, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x45, 0x48, 0x4f, 0x55, 0x53, 0x45, 0x10, 0x03, 0x32,
+	0xce, 0x0f, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x41, 0x50, 0x49, 0x12, 0x70, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x28, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x95, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12,
+	0x2d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0xa3,
+	0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x32, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x2f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x98, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x2e,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x85, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72,
+	0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x2e,
+	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b,
+	0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x6c, 0x6f, 0x67,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b, 0x63, 0x61, 0x72,
+	0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x7c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12,
+	0xa1, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x7e, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a,
+	0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x81, 0x01, 0x0a,
+	0x0e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x27, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x32, 0x5a, 0x30, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_logistics_proto_rawDescOnce sync.Once
+	file_api_v1_logistics_proto_rawDescData = file_api_v1_logistics_proto_rawDesc
+)
 
 func file_api_v1_logistics_proto_rawDescGZIP() []byte {
 	file_api_v1_logistics_proto_rawDescOnce.Do(func() {
@@ -576,33 +3218,139 @@ func file_api_v1_logistics_proto_rawDescGZIP() []byte {
 	return file_api_v1_logistics_proto_rawDescData
 }
 
-var file_api_v1_logistics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_logistics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_logistics_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_api_v1_logistics_proto_goTypes = []interface{}{
-	(*MoveUnitRequest)(nil),                           // 0: logistics.api.v1.MoveUnitRequest
-	(*UnitReachedWarehouseRequest)(nil),               // 1: logistics.api.v1.UnitReachedWarehouseRequest
-	(*DefaultResponse)(nil),                           // 2: logistics.api.v1.DefaultResponse
-	(*DefaultRequest)(nil),                            // 3: logistics.api.v1.DefaultRequest
-	(*DeliveryUnitsWarehouseReceivedTotalNumber)(nil), // 4: logistics.api.v1.DeliveryUnitsWarehouseReceivedTotalNumber
-	(*MetricsReportResponse)(nil),                     // 5: logistics.api.v1.MetricsReportResponse
-	(*WarehouseAnnouncement)(nil),                     // 6: logistics.api.v1.WarehouseAnnouncement
-	(*Location)(nil),                                  // 7: logistics.api.v1.Location
+	(CargoUnitStatus)(0),                              // 0: logistics.api.v1.CargoUnitStatus
+	(MetricsGroupBy)(0),                               // 1: logistics.api.v1.MetricsGroupBy
+	(*MoveUnitRequest)(nil),                           // 2: logistics.api.v1.MoveUnitRequest
+	(*UnitReachedWarehouseRequest)(nil),               // 3: logistics.api.v1.UnitReachedWarehouseRequest
+	(*UnitDepartedWarehouseRequest)(nil),              // 4: logistics.api.v1.UnitDepartedWarehouseRequest
+	(*BatchMoveUnitsRequest)(nil),                     // 5: logistics.api.v1.BatchMoveUnitsRequest
+	(*BatchUnitReachedWarehouseRequest)(nil),          // 6: logistics.api.v1.BatchUnitReachedWarehouseRequest
+	(*GetCargoUnitRequest)(nil),                       // 7: logistics.api.v1.GetCargoUnitRequest
+	(*GetCargoUnitTrackRequest)(nil),                  // 8: logistics.api.v1.GetCargoUnitTrackRequest
+	(*GetCargoUnitStatsRequest)(nil),                  // 9: logistics.api.v1.GetCargoUnitStatsRequest
+	(*ListCargoUnitsRequest)(nil),                     // 10: logistics.api.v1.ListCargoUnitsRequest
+	(*ListCargoUnitEventsRequest)(nil),                // 11: logistics.api.v1.ListCargoUnitEventsRequest
+	(*WatchCargoUnitsRequest)(nil),                    // 12: logistics.api.v1.WatchCargoUnitsRequest
+	(*MetricsReportRequest)(nil),                      // 13: logistics.api.v1.MetricsReportRequest
+	(*WarehouseStatsRequest)(nil),                     // 14: logistics.api.v1.WarehouseStatsRequest
+	(*DefaultResponse)(nil),                           // 15: logistics.api.v1.DefaultResponse
+	(*DefaultRequest)(nil),                            // 16: logistics.api.v1.DefaultRequest
+	(*DeliveryUnitsWarehouseReceivedTotalNumber)(nil), // 17: logistics.api.v1.DeliveryUnitsWarehouseReceivedTotalNumber
+	(*StreamMoveUnitsResponse)(nil),                   // 18: logistics.api.v1.StreamMoveUnitsResponse
+	(*BatchResponse)(nil),                             // 19: logistics.api.v1.BatchResponse
+	(*BatchItemResult)(nil),                           // 20: logistics.api.v1.BatchItemResult
+	(*GetCargoUnitResponse)(nil),                      // 21: logistics.api.v1.GetCargoUnitResponse
+	(*GetCargoUnitTrackResponse)(nil),                 // 22: logistics.api.v1.GetCargoUnitTrackResponse
+	(*GetCargoUnitStatsResponse)(nil),                 // 23: logistics.api.v1.GetCargoUnitStatsResponse
+	(*ListCargoUnitsResponse)(nil),                    // 24: logistics.api.v1.ListCargoUnitsResponse
+	(*ListCargoUnitEventsResponse)(nil),               // 25: logistics.api.v1.ListCargoUnitEventsResponse
+	(*CargoUnitEvent)(nil),                            // 26: logistics.api.v1.CargoUnitEvent
+	(*UnitMoved)(nil),                                 // 27: logistics.api.v1.UnitMoved
+	(*MetricsReportResponse)(nil),                     // 28: logistics.api.v1.MetricsReportResponse
+	(*WarehouseStatsResponse)(nil),                    // 29: logistics.api.v1.WarehouseStatsResponse
+	(*WarehouseAnnouncement)(nil),                     // 30: logistics.api.v1.WarehouseAnnouncement
+	(*CargoUnit)(nil),                                 // 31: logistics.api.v1.CargoUnit
+	(*MetricsBucket)(nil),                             // 32: logistics.api.v1.MetricsBucket
+	(*TravelStats)(nil),                               // 33: logistics.api.v1.TravelStats
+	(*WarehouseArrival)(nil),                          // 34: logistics.api.v1.WarehouseArrival
+	(*WarehouseDeparture)(nil),                        // 35: logistics.api.v1.WarehouseDeparture
+	(*WarehouseStats)(nil),                            // 36: logistics.api.v1.WarehouseStats
+	(*WarehouseVisit)(nil),                            // 37: logistics.api.v1.WarehouseVisit
+	(*Location)(nil),                                  // 38: logistics.api.v1.Location
+	(*timestamppb.Timestamp)(nil),                     // 39: google.protobuf.Timestamp
+	(*status.Status)(nil),                             // 40: google.rpc.Status
+	(*durationpb.Duration)(nil),                       // 41: google.protobuf.Duration
 }
 var file_api_v1_logistics_proto_depIdxs = []int32{
-	7, // 0: logistics.api.v1.MoveUnitRequest.location:type_name -> logistics.api.v1.Location
-	7, // 1: logistics.api.v1.UnitReachedWarehouseRequest.location:type_name -> logistics.api.v1.Location
-	6, // 2: logistics.api.v1.UnitReachedWarehouseRequest.announcement:type_name -> logistics.api.v1.WarehouseAnnouncement
-	4, // 3: logistics.api.v1.MetricsReportResponse.delivery_units_each_warehouse_received_total_number:type_name -> logistics.api.v1.DeliveryUnitsWarehouseReceivedTotalNumber
-	0, // 4: logistics.api.v1.LogisticsEngineAPI.MoveUnit:input_type -> logistics.api.v1.MoveUnitRequest
-	1, // 5: logistics.api.v1.LogisticsEngineAPI.UnitReachedWarehouse:input_type -> logistics.api.v1.UnitReachedWarehouseRequest
-	3, // 6: logistics.api.v1.LogisticsEngineAPI.MetricsReport:input_type -> logistics.api.v1.DefaultRequest
-	2, // 7: logistics.api.v1.LogisticsEngineAPI.MoveUnit:output_type -> logistics.api.v1.DefaultResponse
-	2, // 8: logistics.api.v1.LogisticsEngineAPI.UnitReachedWarehouse:output_type -> logistics.api.v1.DefaultResponse
-	5, // 9: logistics.api.v1.LogisticsEngineAPI.MetricsReport:output_type -> logistics.api.v1.MetricsReportResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	38, // 0: logistics.api.v1.MoveUnitRequest.location:type_name -> logistics.api.v1.Location
+	38, // 1: logistics.api.v1.UnitReachedWarehouseRequest.location:type_name -> logistics.api.v1.Location
+	30, // 2: logistics.api.v1.UnitReachedWarehouseRequest.announcement:type_name -> logistics.api.v1.WarehouseAnnouncement
+	38, // 3: logistics.api.v1.UnitDepartedWarehouseRequest.location:type_name -> logistics.api.v1.Location
+	30, // 4: logistics.api.v1.UnitDepartedWarehouseRequest.announcement:type_name -> logistics.api.v1.WarehouseAnnouncement
+	2,  // 5: logistics.api.v1.BatchMoveUnitsRequest.requests:type_name -> logistics.api.v1.MoveUnitRequest
+	3,  // 6: logistics.api.v1.BatchUnitReachedWarehouseRequest.requests:type_name -> logistics.api.v1.UnitReachedWarehouseRequest
+	0,  // 7: logistics.api.v1.ListCargoUnitsRequest.status:type_name -> logistics.api.v1.CargoUnitStatus
+	39, // 8: logistics.api.v1.ListCargoUnitsRequest.last_seen_after:type_name -> google.protobuf.Timestamp
+	39, // 9: logistics.api.v1.MetricsReportRequest.from:type_name -> google.protobuf.Timestamp
+	39, // 10: logistics.api.v1.MetricsReportRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 11: logistics.api.v1.MetricsReportRequest.group_by:type_name -> logistics.api.v1.MetricsGroupBy
+	39, // 12: logistics.api.v1.WarehouseStatsRequest.from:type_name -> google.protobuf.Timestamp
+	39, // 13: logistics.api.v1.WarehouseStatsRequest.to:type_name -> google.protobuf.Timestamp
+	20, // 14: logistics.api.v1.StreamMoveUnitsResponse.rejected:type_name -> logistics.api.v1.BatchItemResult
+	20, // 15: logistics.api.v1.BatchResponse.results:type_name -> logistics.api.v1.BatchItemResult
+	40, // 16: logistics.api.v1.BatchItemResult.status:type_name -> google.rpc.Status
+	31, // 17: logistics.api.v1.GetCargoUnitResponse.cargo_unit:type_name -> logistics.api.v1.CargoUnit
+	38, // 18: logistics.api.v1.GetCargoUnitTrackResponse.track:type_name -> logistics.api.v1.Location
+	38, // 19: logistics.api.v1.GetCargoUnitTrackResponse.last_location:type_name -> logistics.api.v1.Location
+	34, // 20: logistics.api.v1.GetCargoUnitTrackResponse.warehouse_arrival:type_name -> logistics.api.v1.WarehouseArrival
+	37, // 21: logistics.api.v1.GetCargoUnitTrackResponse.warehouse_visits:type_name -> logistics.api.v1.WarehouseVisit
+	33, // 22: logistics.api.v1.GetCargoUnitStatsResponse.stats:type_name -> logistics.api.v1.TravelStats
+	31, // 23: logistics.api.v1.ListCargoUnitsResponse.cargo_units:type_name -> logistics.api.v1.CargoUnit
+	26, // 24: logistics.api.v1.ListCargoUnitEventsResponse.events:type_name -> logistics.api.v1.CargoUnitEvent
+	39, // 25: logistics.api.v1.CargoUnitEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	27, // 26: logistics.api.v1.CargoUnitEvent.unit_moved:type_name -> logistics.api.v1.UnitMoved
+	34, // 27: logistics.api.v1.CargoUnitEvent.unit_reached_warehouse:type_name -> logistics.api.v1.WarehouseArrival
+	35, // 28: logistics.api.v1.CargoUnitEvent.unit_departed_warehouse:type_name -> logistics.api.v1.WarehouseDeparture
+	39, // 29: logistics.api.v1.CargoUnitEvent.received_at:type_name -> google.protobuf.Timestamp
+	38, // 30: logistics.api.v1.UnitMoved.location:type_name -> logistics.api.v1.Location
+	17, // 31: logistics.api.v1.MetricsReportResponse.delivery_units_each_warehouse_received_total_number:type_name -> logistics.api.v1.DeliveryUnitsWarehouseReceivedTotalNumber
+	33, // 32: logistics.api.v1.MetricsReportResponse.travel_stats:type_name -> logistics.api.v1.TravelStats
+	32, // 33: logistics.api.v1.MetricsReportResponse.series:type_name -> logistics.api.v1.MetricsBucket
+	39, // 34: logistics.api.v1.WarehouseStatsResponse.from:type_name -> google.protobuf.Timestamp
+	39, // 35: logistics.api.v1.WarehouseStatsResponse.to:type_name -> google.protobuf.Timestamp
+	36, // 36: logistics.api.v1.WarehouseStatsResponse.warehouses:type_name -> logistics.api.v1.WarehouseStats
+	38, // 37: logistics.api.v1.CargoUnit.last_location:type_name -> logistics.api.v1.Location
+	34, // 38: logistics.api.v1.CargoUnit.warehouse_arrival:type_name -> logistics.api.v1.WarehouseArrival
+	39, // 39: logistics.api.v1.CargoUnit.last_seen_at:type_name -> google.protobuf.Timestamp
+	39, // 40: logistics.api.v1.MetricsBucket.start:type_name -> google.protobuf.Timestamp
+	38, // 41: logistics.api.v1.WarehouseArrival.location:type_name -> logistics.api.v1.Location
+	30, // 42: logistics.api.v1.WarehouseArrival.announcement:type_name -> logistics.api.v1.WarehouseAnnouncement
+	38, // 43: logistics.api.v1.WarehouseDeparture.location:type_name -> logistics.api.v1.Location
+	30, // 44: logistics.api.v1.WarehouseDeparture.announcement:type_name -> logistics.api.v1.WarehouseAnnouncement
+	41, // 45: logistics.api.v1.WarehouseStats.dwell_p50:type_name -> google.protobuf.Duration
+	41, // 46: logistics.api.v1.WarehouseStats.dwell_p90:type_name -> google.protobuf.Duration
+	41, // 47: logistics.api.v1.WarehouseStats.dwell_p99:type_name -> google.protobuf.Duration
+	34, // 48: logistics.api.v1.WarehouseVisit.arrival:type_name -> logistics.api.v1.WarehouseArrival
+	39, // 49: logistics.api.v1.WarehouseVisit.arrived_at:type_name -> google.protobuf.Timestamp
+	39, // 50: logistics.api.v1.WarehouseVisit.departed_at:type_name -> google.protobuf.Timestamp
+	39, // 51: logistics.api.v1.Location.recorded_at:type_name -> google.protobuf.Timestamp
+	39, // 52: logistics.api.v1.Location.received_at:type_name -> google.protobuf.Timestamp
+	2,  // 53: logistics.api.v1.LogisticsEngineAPI.MoveUnit:input_type -> logistics.api.v1.MoveUnitRequest
+	2,  // 54: logistics.api.v1.LogisticsEngineAPI.StreamMoveUnits:input_type -> logistics.api.v1.MoveUnitRequest
+	5,  // 55: logistics.api.v1.LogisticsEngineAPI.BatchMoveUnits:input_type -> logistics.api.v1.BatchMoveUnitsRequest
+	3,  // 56: logistics.api.v1.LogisticsEngineAPI.UnitReachedWarehouse:input_type -> logistics.api.v1.UnitReachedWarehouseRequest
+	6,  // 57: logistics.api.v1.LogisticsEngineAPI.BatchUnitReachedWarehouse:input_type -> logistics.api.v1.BatchUnitReachedWarehouseRequest
+	4,  // 58: logistics.api.v1.LogisticsEngineAPI.UnitDepartedWarehouse:input_type -> logistics.api.v1.UnitDepartedWarehouseRequest
+	7,  // 59: logistics.api.v1.LogisticsEngineAPI.GetCargoUnit:input_type -> logistics.api.v1.GetCargoUnitRequest
+	8,  // 60: logistics.api.v1.LogisticsEngineAPI.GetCargoUnitTrack:input_type -> logistics.api.v1.GetCargoUnitTrackRequest
+	9,  // 61: logistics.api.v1.LogisticsEngineAPI.GetCargoUnitStats:input_type -> logistics.api.v1.GetCargoUnitStatsRequest
+	10, // 62: logistics.api.v1.LogisticsEngineAPI.ListCargoUnits:input_type -> logistics.api.v1.ListCargoUnitsRequest
+	11, // 63: logistics.api.v1.LogisticsEngineAPI.ListCargoUnitEvents:input_type -> logistics.api.v1.ListCargoUnitEventsRequest
+	12, // 64: logistics.api.v1.LogisticsEngineAPI.WatchCargoUnits:input_type -> logistics.api.v1.WatchCargoUnitsRequest
+	13, // 65: logistics.api.v1.LogisticsEngineAPI.MetricsReport:input_type -> logistics.api.v1.MetricsReportRequest
+	14, // 66: logistics.api.v1.LogisticsEngineAPI.WarehouseStats:input_type -> logistics.api.v1.WarehouseStatsRequest
+	15, // 67: logistics.api.v1.LogisticsEngineAPI.MoveUnit:output_type -> logistics.api.v1.DefaultResponse
+	18, // 68: logistics.api.v1.LogisticsEngineAPI.StreamMoveUnits:output_type -> logistics.api.v1.StreamMoveUnitsResponse
+	19, // 69: logistics.api.v1.LogisticsEngineAPI.BatchMoveUnits:output_type -> logistics.api.v1.BatchResponse
+	15, // 70: logistics.api.v1.LogisticsEngineAPI.UnitReachedWarehouse:output_type -> logistics.api.v1.DefaultResponse
+	19, // 71: logistics.api.v1.LogisticsEngineAPI.BatchUnitReachedWarehouse:output_type -> logistics.api.v1.BatchResponse
+	15, // 72: logistics.api.v1.LogisticsEngineAPI.UnitDepartedWarehouse:output_type -> logistics.api.v1.DefaultResponse
+	21, // 73: logistics.api.v1.LogisticsEngineAPI.GetCargoUnit:output_type -> logistics.api.v1.GetCargoUnitResponse
+	22, // 74: logistics.api.v1.LogisticsEngineAPI.GetCargoUnitTrack:output_type -> logistics.api.v1.GetCargoUnitTrackResponse
+	23, // 75: logistics.api.v1.LogisticsEngineAPI.GetCargoUnitStats:output_type -> logistics.api.v1.GetCargoUnitStatsResponse
+	24, // 76: logistics.api.v1.LogisticsEngineAPI.ListCargoUnits:output_type -> logistics.api.v1.ListCargoUnitsResponse
+	25, // 77: logistics.api.v1.LogisticsEngineAPI.ListCargoUnitEvents:output_type -> logistics.api.v1.ListCargoUnitEventsResponse
+	26, // 78: logistics.api.v1.LogisticsEngineAPI.WatchCargoUnits:output_type -> logistics.api.v1.CargoUnitEvent
+	28, // 79: logistics.api.v1.LogisticsEngineAPI.MetricsReport:output_type -> logistics.api.v1.MetricsReportResponse
+	29, // 80: logistics.api.v1.LogisticsEngineAPI.WarehouseStats:output_type -> logistics.api.v1.WarehouseStatsResponse
+	67, // [67:81] is the sub-list for method output_type
+	53, // [53:67] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_logistics_proto_init() }
@@ -623,8 +3371,68 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnitReachedWarehouseRequest); i {
+		file_api_v1_logistics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitReachedWarehouseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitDepartedWarehouseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMoveUnitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUnitReachedWarehouseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitTrackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -635,7 +3443,79 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCargoUnitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCargoUnitEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCargoUnitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DefaultResponse); i {
 			case 0:
 				return &v.state
@@ -647,7 +3527,7 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DefaultRequest); i {
 			case 0:
 				return &v.state
@@ -659,7 +3539,7 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliveryUnitsWarehouseReceivedTotalNumber); i {
 			case 0:
 				return &v.state
@@ -671,7 +3551,127 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMoveUnitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCargoUnitStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCargoUnitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCargoUnitEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CargoUnitEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitMoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsReportResponse); i {
 			case 0:
 				return &v.state
@@ -683,7 +3683,19 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarehouseAnnouncement); i {
 			case 0:
 				return &v.state
@@ -695,7 +3707,91 @@ func file_api_v1_logistics_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_logistics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_logistics_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CargoUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TravelStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseArrival); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseDeparture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseVisit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_logistics_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Location); i {
 			case 0:
 				return &v.state
@@ -708,18 +3804,24 @@ func file_api_v1_logistics_proto_init() {
 			}
 		}
 	}
+	file_api_v1_logistics_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*CargoUnitEvent_UnitMoved)(nil),
+		(*CargoUnitEvent_UnitReachedWarehouse)(nil),
+		(*CargoUnitEvent_UnitDepartedWarehouse)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_logistics_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_logistics_proto_goTypes,
 		DependencyIndexes: file_api_v1_logistics_proto_depIdxs,
+		EnumInfos:         file_api_v1_logistics_proto_enumTypes,
 		MessageInfos:      file_api_v1_logistics_proto_msgTypes,
 	}.Build()
 	File_api_v1_logistics_proto = out.File
@@ -0,0 +1,5784 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/logistics.proto
+
+package logistics_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on MoveUnitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveUnitRequestMultiError, or nil if none found.
+func (m *MoveUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := MoveUnitRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocation() == nil {
+		err := MoveUnitRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveUnitRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveUnitRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveUnitRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := MoveUnitRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveUnitRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveUnitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveUnitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveUnitRequestMultiError) AllErrors() []error { return m }
+
+// MoveUnitRequestValidationError is the validation error returned by
+// MoveUnitRequest.Validate if the designated constraints aren't met.
+type MoveUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveUnitRequestValidationError) ErrorName() string { return "MoveUnitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveUnitRequestValidationError{}
+
+// Validate checks the field values on UnitReachedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnitReachedWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitReachedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnitReachedWarehouseRequestMultiError, or nil if none found.
+func (m *UnitReachedWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitReachedWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLocation() == nil {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitReachedWarehouseRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitReachedWarehouseRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnitReachedWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitReachedWarehouseRequestMultiError is an error wrapping multiple
+// validation errors returned by UnitReachedWarehouseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnitReachedWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitReachedWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitReachedWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// UnitReachedWarehouseRequestValidationError is the validation error returned
+// by UnitReachedWarehouseRequest.Validate if the designated constraints
+// aren't met.
+type UnitReachedWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitReachedWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitReachedWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitReachedWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitReachedWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitReachedWarehouseRequestValidationError) ErrorName() string {
+	return "UnitReachedWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnitReachedWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitReachedWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitReachedWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitReachedWarehouseRequestValidationError{}
+
+// Validate checks the field values on UnitDepartedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnitDepartedWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitDepartedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnitDepartedWarehouseRequestMultiError, or nil if none found.
+func (m *UnitDepartedWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitDepartedWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLocation() == nil {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitDepartedWarehouseRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitDepartedWarehouseRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnitDepartedWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitDepartedWarehouseRequestMultiError is an error wrapping multiple
+// validation errors returned by UnitDepartedWarehouseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnitDepartedWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitDepartedWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitDepartedWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// UnitDepartedWarehouseRequestValidationError is the validation error returned
+// by UnitDepartedWarehouseRequest.Validate if the designated constraints
+// aren't met.
+type UnitDepartedWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitDepartedWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitDepartedWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitDepartedWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitDepartedWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitDepartedWarehouseRequestValidationError) ErrorName() string {
+	return "UnitDepartedWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnitDepartedWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitDepartedWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitDepartedWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitDepartedWarehouseRequestValidationError{}
+
+// Validate checks the field values on BatchMoveUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchMoveUnitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchMoveUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchMoveUnitsRequestMultiError, or nil if none found.
+func (m *BatchMoveUnitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchMoveUnitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetRequests()); l < 1 || l > 1000 {
+		err := BatchMoveUnitsRequestValidationError{
+			field:  "Requests",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		// skipping validation for requests
+
+	}
+
+	if len(errors) > 0 {
+		return BatchMoveUnitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchMoveUnitsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchMoveUnitsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchMoveUnitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchMoveUnitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchMoveUnitsRequestMultiError) AllErrors() []error { return m }
+
+// BatchMoveUnitsRequestValidationError is the validation error returned by
+// BatchMoveUnitsRequest.Validate if the designated constraints aren't met.
+type BatchMoveUnitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchMoveUnitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchMoveUnitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchMoveUnitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchMoveUnitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchMoveUnitsRequestValidationError) ErrorName() string {
+	return "BatchMoveUnitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchMoveUnitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchMoveUnitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchMoveUnitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchMoveUnitsRequestValidationError{}
+
+// Validate checks the field values on BatchUnitReachedWarehouseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchUnitReachedWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUnitReachedWarehouseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchUnitReachedWarehouseRequestMultiError, or nil if none found.
+func (m *BatchUnitReachedWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUnitReachedWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetRequests()); l < 1 || l > 1000 {
+		err := BatchUnitReachedWarehouseRequestValidationError{
+			field:  "Requests",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		// skipping validation for requests
+
+	}
+
+	if len(errors) > 0 {
+		return BatchUnitReachedWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUnitReachedWarehouseRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchUnitReachedWarehouseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchUnitReachedWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUnitReachedWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUnitReachedWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// BatchUnitReachedWarehouseRequestValidationError is the validation error
+// returned by BatchUnitReachedWarehouseRequest.Validate if the designated
+// constraints aren't met.
+type BatchUnitReachedWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUnitReachedWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUnitReachedWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUnitReachedWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUnitReachedWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUnitReachedWarehouseRequestValidationError) ErrorName() string {
+	return "BatchUnitReachedWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUnitReachedWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUnitReachedWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUnitReachedWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUnitReachedWarehouseRequestValidationError{}
+
+// Validate checks the field values on GetCargoUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitRequestMultiError, or nil if none found.
+func (m *GetCargoUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := GetCargoUnitRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCargoUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitRequestMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitRequestValidationError is the validation error returned by
+// GetCargoUnitRequest.Validate if the designated constraints aren't met.
+type GetCargoUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitRequestValidationError) ErrorName() string {
+	return "GetCargoUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitRequestValidationError{}
+
+// Validate checks the field values on GetCargoUnitTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitTrackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitTrackRequestMultiError, or nil if none found.
+func (m *GetCargoUnitTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := GetCargoUnitTrackRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitTrackRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitTrackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCargoUnitTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitTrackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitTrackRequestMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitTrackRequestValidationError is the validation error returned by
+// GetCargoUnitTrackRequest.Validate if the designated constraints aren't met.
+type GetCargoUnitTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitTrackRequestValidationError) ErrorName() string {
+	return "GetCargoUnitTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitTrackRequestValidationError{}
+
+// Validate checks the field values on GetCargoUnitStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitStatsRequestMultiError, or nil if none found.
+func (m *GetCargoUnitStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := GetCargoUnitStatsRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCargoUnitStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitStatsRequestValidationError is the validation error returned by
+// GetCargoUnitStatsRequest.Validate if the designated constraints aren't met.
+type GetCargoUnitStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitStatsRequestValidationError) ErrorName() string {
+	return "GetCargoUnitStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitStatsRequestValidationError{}
+
+// Validate checks the field values on ListCargoUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCargoUnitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCargoUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCargoUnitsRequestMultiError, or nil if none found.
+func (m *ListCargoUnitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCargoUnitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListCargoUnitsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 64 {
+		err := ListCargoUnitsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWarehouseId() < 0 {
+		err := ListCargoUnitsRequestValidationError{
+			field:  "WarehouseId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CargoUnitStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListCargoUnitsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCargoUnitsRequestValidationError{
+					field:  "LastSeenAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCargoUnitsRequestValidationError{
+					field:  "LastSeenAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCargoUnitsRequestValidationError{
+				field:  "LastSeenAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListCargoUnitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCargoUnitsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCargoUnitsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCargoUnitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCargoUnitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCargoUnitsRequestMultiError) AllErrors() []error { return m }
+
+// ListCargoUnitsRequestValidationError is the validation error returned by
+// ListCargoUnitsRequest.Validate if the designated constraints aren't met.
+type ListCargoUnitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCargoUnitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCargoUnitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCargoUnitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCargoUnitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCargoUnitsRequestValidationError) ErrorName() string {
+	return "ListCargoUnitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCargoUnitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCargoUnitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCargoUnitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCargoUnitsRequestValidationError{}
+
+// Validate checks the field values on ListCargoUnitEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCargoUnitEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCargoUnitEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCargoUnitEventsRequestMultiError, or nil if none found.
+func (m *ListCargoUnitEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCargoUnitEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := ListCargoUnitEventsRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListCargoUnitEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 64 {
+		err := ListCargoUnitEventsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCargoUnitEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCargoUnitEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCargoUnitEventsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCargoUnitEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCargoUnitEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCargoUnitEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListCargoUnitEventsRequestValidationError is the validation error returned
+// by ListCargoUnitEventsRequest.Validate if the designated constraints aren't met.
+type ListCargoUnitEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCargoUnitEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCargoUnitEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCargoUnitEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCargoUnitEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCargoUnitEventsRequestValidationError) ErrorName() string {
+	return "ListCargoUnitEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCargoUnitEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCargoUnitEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCargoUnitEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCargoUnitEventsRequestValidationError{}
+
+// Validate checks the field values on WatchCargoUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchCargoUnitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchCargoUnitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchCargoUnitsRequestMultiError, or nil if none found.
+func (m *WatchCargoUnitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchCargoUnitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCargoUnitIds()) > 1000 {
+		err := WatchCargoUnitsRequestValidationError{
+			field:  "CargoUnitIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_WatchCargoUnitsRequest_CargoUnitIds_Unique := make(map[int64]struct{}, len(m.GetCargoUnitIds()))
+
+	for idx, item := range m.GetCargoUnitIds() {
+		_, _ = idx, item
+
+		if _, exists := _WatchCargoUnitsRequest_CargoUnitIds_Unique[item]; exists {
+			err := WatchCargoUnitsRequestValidationError{
+				field:  fmt.Sprintf("CargoUnitIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_WatchCargoUnitsRequest_CargoUnitIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := WatchCargoUnitsRequestValidationError{
+				field:  fmt.Sprintf("CargoUnitIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetWarehouseId() < 0 {
+		err := WatchCargoUnitsRequestValidationError{
+			field:  "WarehouseId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchCargoUnitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchCargoUnitsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchCargoUnitsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchCargoUnitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchCargoUnitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchCargoUnitsRequestMultiError) AllErrors() []error { return m }
+
+// WatchCargoUnitsRequestValidationError is the validation error returned by
+// WatchCargoUnitsRequest.Validate if the designated constraints aren't met.
+type WatchCargoUnitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchCargoUnitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchCargoUnitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchCargoUnitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchCargoUnitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchCargoUnitsRequestValidationError) ErrorName() string {
+	return "WatchCargoUnitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchCargoUnitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchCargoUnitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchCargoUnitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchCargoUnitsRequestValidationError{}
+
+// Validate checks the field values on MetricsReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsReportRequestMultiError, or nil if none found.
+func (m *MetricsReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsReportRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsReportRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsReportRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsReportRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsReportRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsReportRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetWarehouseIds()) > 100 {
+		err := MetricsReportRequestValidationError{
+			field:  "WarehouseIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MetricsReportRequest_WarehouseIds_Unique := make(map[int64]struct{}, len(m.GetWarehouseIds()))
+
+	for idx, item := range m.GetWarehouseIds() {
+		_, _ = idx, item
+
+		if _, exists := _MetricsReportRequest_WarehouseIds_Unique[item]; exists {
+			err := MetricsReportRequestValidationError{
+				field:  fmt.Sprintf("WarehouseIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MetricsReportRequest_WarehouseIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := MetricsReportRequestValidationError{
+				field:  fmt.Sprintf("WarehouseIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := MetricsGroupBy_name[int32(m.GetGroupBy())]; !ok {
+		err := MetricsReportRequestValidationError{
+			field:  "GroupBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MetricsReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsReportRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsReportRequestMultiError) AllErrors() []error { return m }
+
+// MetricsReportRequestValidationError is the validation error returned by
+// MetricsReportRequest.Validate if the designated constraints aren't met.
+type MetricsReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsReportRequestValidationError) ErrorName() string {
+	return "MetricsReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsReportRequestValidationError{}
+
+// Validate checks the field values on WarehouseStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseStatsRequestMultiError, or nil if none found.
+func (m *WarehouseStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWarehouseId() < 0 {
+		err := WarehouseStatsRequestValidationError{
+			field:  "WarehouseId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by WarehouseStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WarehouseStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseStatsRequestMultiError) AllErrors() []error { return m }
+
+// WarehouseStatsRequestValidationError is the validation error returned by
+// WarehouseStatsRequest.Validate if the designated constraints aren't met.
+type WarehouseStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseStatsRequestValidationError) ErrorName() string {
+	return "WarehouseStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarehouseStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseStatsRequestValidationError{}
+
+// Validate checks the field values on DefaultResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DefaultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DefaultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DefaultResponseMultiError, or nil if none found.
+func (m *DefaultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DefaultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DefaultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DefaultResponseMultiError is an error wrapping multiple validation errors
+// returned by DefaultResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DefaultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DefaultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DefaultResponseMultiError) AllErrors() []error { return m }
+
+// DefaultResponseValidationError is the validation error returned by
+// DefaultResponse.Validate if the designated constraints aren't met.
+type DefaultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DefaultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DefaultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DefaultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DefaultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DefaultResponseValidationError) ErrorName() string { return "DefaultResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DefaultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDefaultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DefaultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DefaultResponseValidationError{}
+
+// Validate checks the field values on DefaultRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DefaultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DefaultRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DefaultRequestMultiError,
+// or nil if none found.
+func (m *DefaultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DefaultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DefaultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DefaultRequestMultiError is an error wrapping multiple validation errors
+// returned by DefaultRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DefaultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DefaultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DefaultRequestMultiError) AllErrors() []error { return m }
+
+// DefaultRequestValidationError is the validation error returned by
+// DefaultRequest.Validate if the designated constraints aren't met.
+type DefaultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DefaultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DefaultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DefaultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DefaultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DefaultRequestValidationError) ErrorName() string { return "DefaultRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DefaultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDefaultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DefaultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DefaultRequestValidationError{}
+
+// Validate checks the field values on
+// DeliveryUnitsWarehouseReceivedTotalNumber with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeliveryUnitsWarehouseReceivedTotalNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeliveryUnitsWarehouseReceivedTotalNumber with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeliveryUnitsWarehouseReceivedTotalNumberMultiError, or nil if none found.
+func (m *DeliveryUnitsWarehouseReceivedTotalNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliveryUnitsWarehouseReceivedTotalNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseId
+
+	// no validation rules for DeliveryUnitsNumber
+
+	if len(errors) > 0 {
+		return DeliveryUnitsWarehouseReceivedTotalNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliveryUnitsWarehouseReceivedTotalNumberMultiError is an error wrapping
+// multiple validation errors returned by
+// DeliveryUnitsWarehouseReceivedTotalNumber.ValidateAll() if the designated
+// constraints aren't met.
+type DeliveryUnitsWarehouseReceivedTotalNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliveryUnitsWarehouseReceivedTotalNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliveryUnitsWarehouseReceivedTotalNumberMultiError) AllErrors() []error { return m }
+
+// DeliveryUnitsWarehouseReceivedTotalNumberValidationError is the validation
+// error returned by DeliveryUnitsWarehouseReceivedTotalNumber.Validate if the
+// designated constraints aren't met.
+type DeliveryUnitsWarehouseReceivedTotalNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) ErrorName() string {
+	return "DeliveryUnitsWarehouseReceivedTotalNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeliveryUnitsWarehouseReceivedTotalNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliveryUnitsWarehouseReceivedTotalNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliveryUnitsWarehouseReceivedTotalNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliveryUnitsWarehouseReceivedTotalNumberValidationError{}
+
+// Validate checks the field values on StreamMoveUnitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamMoveUnitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamMoveUnitsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamMoveUnitsResponseMultiError, or nil if none found.
+func (m *StreamMoveUnitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamMoveUnitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AcceptedNumber
+
+	// no validation rules for RejectedNumber
+
+	for idx, item := range m.GetRejected() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamMoveUnitsResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamMoveUnitsResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamMoveUnitsResponseValidationError{
+					field:  fmt.Sprintf("Rejected[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StreamMoveUnitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamMoveUnitsResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamMoveUnitsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamMoveUnitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamMoveUnitsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamMoveUnitsResponseMultiError) AllErrors() []error { return m }
+
+// StreamMoveUnitsResponseValidationError is the validation error returned by
+// StreamMoveUnitsResponse.Validate if the designated constraints aren't met.
+type StreamMoveUnitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamMoveUnitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamMoveUnitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamMoveUnitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamMoveUnitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamMoveUnitsResponseValidationError) ErrorName() string {
+	return "StreamMoveUnitsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamMoveUnitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamMoveUnitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamMoveUnitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamMoveUnitsResponseValidationError{}
+
+// Validate checks the field values on BatchResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchResponseMultiError, or
+// nil if none found.
+func (m *BatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AcceptedNumber
+
+	// no validation rules for RejectedNumber
+
+	if len(errors) > 0 {
+		return BatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchResponseMultiError is an error wrapping multiple validation errors
+// returned by BatchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchResponseMultiError) AllErrors() []error { return m }
+
+// BatchResponseValidationError is the validation error returned by
+// BatchResponse.Validate if the designated constraints aren't met.
+type BatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchResponseValidationError) ErrorName() string { return "BatchResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchResponseValidationError{}
+
+// Validate checks the field values on BatchItemResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchItemResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchItemResultMultiError, or nil if none found.
+func (m *BatchItemResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchItemResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for CargoUnitId
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchItemResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchItemResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchItemResultValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchItemResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchItemResultMultiError is an error wrapping multiple validation errors
+// returned by BatchItemResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchItemResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchItemResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchItemResultMultiError) AllErrors() []error { return m }
+
+// BatchItemResultValidationError is the validation error returned by
+// BatchItemResult.Validate if the designated constraints aren't met.
+type BatchItemResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchItemResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchItemResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchItemResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchItemResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchItemResultValidationError) ErrorName() string { return "BatchItemResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchItemResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchItemResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchItemResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchItemResultValidationError{}
+
+// Validate checks the field values on GetCargoUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitResponseMultiError, or nil if none found.
+func (m *GetCargoUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCargoUnit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitResponseValidationError{
+					field:  "CargoUnit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitResponseValidationError{
+					field:  "CargoUnit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCargoUnit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitResponseValidationError{
+				field:  "CargoUnit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCargoUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitResponseMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitResponseValidationError is the validation error returned by
+// GetCargoUnitResponse.Validate if the designated constraints aren't met.
+type GetCargoUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitResponseValidationError) ErrorName() string {
+	return "GetCargoUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitResponseValidationError{}
+
+// Validate checks the field values on GetCargoUnitTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitTrackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitTrackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitTrackResponseMultiError, or nil if none found.
+func (m *GetCargoUnitTrackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitTrackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CargoUnitId
+
+	for idx, item := range m.GetTrack() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("Track[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("Track[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCargoUnitTrackResponseValidationError{
+					field:  fmt.Sprintf("Track[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitTrackResponseValidationError{
+				field:  "LastLocation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWarehouseArrival()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWarehouseArrival()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitTrackResponseValidationError{
+				field:  "WarehouseArrival",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	for idx, item := range m.GetWarehouseVisits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCargoUnitTrackResponseValidationError{
+					field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitTrackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitTrackResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitTrackResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCargoUnitTrackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitTrackResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitTrackResponseMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitTrackResponseValidationError is the validation error returned by
+// GetCargoUnitTrackResponse.Validate if the designated constraints aren't met.
+type GetCargoUnitTrackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitTrackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitTrackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitTrackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitTrackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitTrackResponseValidationError) ErrorName() string {
+	return "GetCargoUnitTrackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitTrackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitTrackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitTrackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitTrackResponseValidationError{}
+
+// Validate checks the field values on GetCargoUnitStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitStatsResponseMultiError, or nil if none found.
+func (m *GetCargoUnitStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CargoUnitId
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitStatsResponseValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitStatsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCargoUnitStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitStatsResponseValidationError is the validation error returned by
+// GetCargoUnitStatsResponse.Validate if the designated constraints aren't met.
+type GetCargoUnitStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitStatsResponseValidationError) ErrorName() string {
+	return "GetCargoUnitStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitStatsResponseValidationError{}
+
+// Validate checks the field values on ListCargoUnitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCargoUnitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCargoUnitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCargoUnitsResponseMultiError, or nil if none found.
+func (m *ListCargoUnitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCargoUnitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCargoUnits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCargoUnitsResponseValidationError{
+						field:  fmt.Sprintf("CargoUnits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCargoUnitsResponseValidationError{
+						field:  fmt.Sprintf("CargoUnits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCargoUnitsResponseValidationError{
+					field:  fmt.Sprintf("CargoUnits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCargoUnitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCargoUnitsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCargoUnitsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCargoUnitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCargoUnitsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCargoUnitsResponseMultiError) AllErrors() []error { return m }
+
+// ListCargoUnitsResponseValidationError is the validation error returned by
+// ListCargoUnitsResponse.Validate if the designated constraints aren't met.
+type ListCargoUnitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCargoUnitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCargoUnitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCargoUnitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCargoUnitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCargoUnitsResponseValidationError) ErrorName() string {
+	return "ListCargoUnitsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCargoUnitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCargoUnitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCargoUnitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCargoUnitsResponseValidationError{}
+
+// Validate checks the field values on ListCargoUnitEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCargoUnitEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCargoUnitEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCargoUnitEventsResponseMultiError, or nil if none found.
+func (m *ListCargoUnitEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCargoUnitEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCargoUnitEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCargoUnitEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCargoUnitEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCargoUnitEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCargoUnitEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCargoUnitEventsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCargoUnitEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCargoUnitEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCargoUnitEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListCargoUnitEventsResponseValidationError is the validation error returned
+// by ListCargoUnitEventsResponse.Validate if the designated constraints
+// aren't met.
+type ListCargoUnitEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCargoUnitEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCargoUnitEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCargoUnitEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCargoUnitEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCargoUnitEventsResponseValidationError) ErrorName() string {
+	return "ListCargoUnitEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCargoUnitEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCargoUnitEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCargoUnitEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCargoUnitEventsResponseValidationError{}
+
+// Validate checks the field values on CargoUnitEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CargoUnitEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CargoUnitEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CargoUnitEventMultiError,
+// or nil if none found.
+func (m *CargoUnitEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CargoUnitEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CargoUnitId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CargoUnitEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CargoUnitEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CargoUnitEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sequence
+
+	if all {
+		switch v := interface{}(m.GetReceivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CargoUnitEventValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CargoUnitEventValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CargoUnitEventValidationError{
+				field:  "ReceivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Event.(type) {
+	case *CargoUnitEvent_UnitMoved:
+		if v == nil {
+			err := CargoUnitEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnitMoved()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitMoved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitMoved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnitMoved()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CargoUnitEventValidationError{
+					field:  "UnitMoved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CargoUnitEvent_UnitReachedWarehouse:
+		if v == nil {
+			err := CargoUnitEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnitReachedWarehouse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitReachedWarehouse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitReachedWarehouse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnitReachedWarehouse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CargoUnitEventValidationError{
+					field:  "UnitReachedWarehouse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CargoUnitEvent_UnitDepartedWarehouse:
+		if v == nil {
+			err := CargoUnitEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnitDepartedWarehouse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitDepartedWarehouse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CargoUnitEventValidationError{
+						field:  "UnitDepartedWarehouse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnitDepartedWarehouse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CargoUnitEventValidationError{
+					field:  "UnitDepartedWarehouse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CargoUnitEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// CargoUnitEventMultiError is an error wrapping multiple validation errors
+// returned by CargoUnitEvent.ValidateAll() if the designated constraints
+// aren't met.
+type CargoUnitEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CargoUnitEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CargoUnitEventMultiError) AllErrors() []error { return m }
+
+// CargoUnitEventValidationError is the validation error returned by
+// CargoUnitEvent.Validate if the designated constraints aren't met.
+type CargoUnitEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CargoUnitEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CargoUnitEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CargoUnitEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CargoUnitEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CargoUnitEventValidationError) ErrorName() string { return "CargoUnitEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CargoUnitEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCargoUnitEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CargoUnitEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CargoUnitEventValidationError{}
+
+// Validate checks the field values on UnitMoved with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnitMoved) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitMoved with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnitMovedMultiError, or nil
+// if none found.
+func (m *UnitMoved) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitMoved) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitMovedValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitMovedValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitMovedValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnitMovedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitMovedMultiError is an error wrapping multiple validation errors returned
+// by UnitMoved.ValidateAll() if the designated constraints aren't met.
+type UnitMovedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitMovedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitMovedMultiError) AllErrors() []error { return m }
+
+// UnitMovedValidationError is the validation error returned by
+// UnitMoved.Validate if the designated constraints aren't met.
+type UnitMovedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitMovedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitMovedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitMovedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitMovedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitMovedValidationError) ErrorName() string { return "UnitMovedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnitMovedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitMoved.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitMovedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitMovedValidationError{}
+
+// Validate checks the field values on MetricsReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsReportResponseMultiError, or nil if none found.
+func (m *MetricsReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeliveryUnitsNumber
+
+	for idx, item := range m.GetDeliveryUnitsEachWarehouseReceivedTotalNumber() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsReportResponseValidationError{
+						field:  fmt.Sprintf("DeliveryUnitsEachWarehouseReceivedTotalNumber[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsReportResponseValidationError{
+						field:  fmt.Sprintf("DeliveryUnitsEachWarehouseReceivedTotalNumber[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsReportResponseValidationError{
+					field:  fmt.Sprintf("DeliveryUnitsEachWarehouseReceivedTotalNumber[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTravelStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsReportResponseValidationError{
+					field:  "TravelStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsReportResponseValidationError{
+					field:  "TravelStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTravelStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsReportResponseValidationError{
+				field:  "TravelStats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InTransitUnitsNumber
+
+	// no validation rules for DeliveryUnitsReachedDestinationNumber
+
+	for idx, item := range m.GetSeries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsReportResponseValidationError{
+						field:  fmt.Sprintf("Series[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsReportResponseValidationError{
+						field:  fmt.Sprintf("Series[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsReportResponseValidationError{
+					field:  fmt.Sprintf("Series[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsReportResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsReportResponseMultiError) AllErrors() []error { return m }
+
+// MetricsReportResponseValidationError is the validation error returned by
+// MetricsReportResponse.Validate if the designated constraints aren't met.
+type MetricsReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsReportResponseValidationError) ErrorName() string {
+	return "MetricsReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsReportResponseValidationError{}
+
+// Validate checks the field values on WarehouseStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseStatsResponseMultiError, or nil if none found.
+func (m *WarehouseStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsResponseValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsResponseValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsResponseValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsResponseValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsResponseValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsResponseValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWarehouses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WarehouseStatsResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WarehouseStatsResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WarehouseStatsResponseValidationError{
+					field:  fmt.Sprintf("Warehouses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WarehouseStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by WarehouseStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WarehouseStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseStatsResponseMultiError) AllErrors() []error { return m }
+
+// WarehouseStatsResponseValidationError is the validation error returned by
+// WarehouseStatsResponse.Validate if the designated constraints aren't met.
+type WarehouseStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseStatsResponseValidationError) ErrorName() string {
+	return "WarehouseStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarehouseStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseStatsResponseValidationError{}
+
+// Validate checks the field values on WarehouseAnnouncement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseAnnouncement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseAnnouncement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseAnnouncementMultiError, or nil if none found.
+func (m *WarehouseAnnouncement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseAnnouncement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWarehouseId() <= 0 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "WarehouseId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) > 1024 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "Message",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WarehouseAnnouncementMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseAnnouncementMultiError is an error wrapping multiple validation
+// errors returned by WarehouseAnnouncement.ValidateAll() if the designated
+// constraints aren't met.
+type WarehouseAnnouncementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseAnnouncementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseAnnouncementMultiError) AllErrors() []error { return m }
+
+// WarehouseAnnouncementValidationError is the validation error returned by
+// WarehouseAnnouncement.Validate if the designated constraints aren't met.
+type WarehouseAnnouncementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseAnnouncementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseAnnouncementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseAnnouncementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseAnnouncementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseAnnouncementValidationError) ErrorName() string {
+	return "WarehouseAnnouncementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarehouseAnnouncementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseAnnouncement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseAnnouncementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseAnnouncementValidationError{}
+
+// Validate checks the field values on CargoUnit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CargoUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CargoUnit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CargoUnitMultiError, or nil
+// if none found.
+func (m *CargoUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CargoUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CargoUnitId
+
+	if all {
+		switch v := interface{}(m.GetLastLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CargoUnitValidationError{
+				field:  "LastLocation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LocationsNumber
+
+	if all {
+		switch v := interface{}(m.GetWarehouseArrival()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWarehouseArrival()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CargoUnitValidationError{
+				field:  "WarehouseArrival",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CargoUnitValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CargoUnitValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	// no validation rules for WarehouseVisitsNumber
+
+	if len(errors) > 0 {
+		return CargoUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// CargoUnitMultiError is an error wrapping multiple validation errors returned
+// by CargoUnit.ValidateAll() if the designated constraints aren't met.
+type CargoUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CargoUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CargoUnitMultiError) AllErrors() []error { return m }
+
+// CargoUnitValidationError is the validation error returned by
+// CargoUnit.Validate if the designated constraints aren't met.
+type CargoUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CargoUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CargoUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CargoUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CargoUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CargoUnitValidationError) ErrorName() string { return "CargoUnitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CargoUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCargoUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CargoUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CargoUnitValidationError{}
+
+// Validate checks the field values on MetricsBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricsBucketMultiError, or
+// nil if none found.
+func (m *MetricsBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsBucketValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WarehouseId
+
+	// no validation rules for Arrivals
+
+	// no validation rules for ActiveUnits
+
+	if len(errors) > 0 {
+		return MetricsBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsBucketMultiError is an error wrapping multiple validation errors
+// returned by MetricsBucket.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsBucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsBucketMultiError) AllErrors() []error { return m }
+
+// MetricsBucketValidationError is the validation error returned by
+// MetricsBucket.Validate if the designated constraints aren't met.
+type MetricsBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsBucketValidationError) ErrorName() string { return "MetricsBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsBucketValidationError{}
+
+// Validate checks the field values on TravelStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TravelStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TravelStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TravelStatsMultiError, or
+// nil if none found.
+func (m *TravelStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TravelStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DistanceMeters
+
+	// no validation rules for StraightLineMeters
+
+	// no validation rules for PathRatio
+
+	// no validation rules for AverageSpeed
+
+	// no validation rules for MaxSpeed
+
+	if len(errors) > 0 {
+		return TravelStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TravelStatsMultiError is an error wrapping multiple validation errors
+// returned by TravelStats.ValidateAll() if the designated constraints aren't met.
+type TravelStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TravelStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TravelStatsMultiError) AllErrors() []error { return m }
+
+// TravelStatsValidationError is the validation error returned by
+// TravelStats.Validate if the designated constraints aren't met.
+type TravelStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TravelStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TravelStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TravelStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TravelStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TravelStatsValidationError) ErrorName() string { return "TravelStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TravelStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTravelStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TravelStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TravelStatsValidationError{}
+
+// Validate checks the field values on WarehouseArrival with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseArrival) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseArrival with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseArrivalMultiError, or nil if none found.
+func (m *WarehouseArrival) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseArrival) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseArrivalValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseArrivalValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseArrivalMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseArrivalMultiError is an error wrapping multiple validation errors
+// returned by WarehouseArrival.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseArrivalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseArrivalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseArrivalMultiError) AllErrors() []error { return m }
+
+// WarehouseArrivalValidationError is the validation error returned by
+// WarehouseArrival.Validate if the designated constraints aren't met.
+type WarehouseArrivalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseArrivalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseArrivalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseArrivalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseArrivalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseArrivalValidationError) ErrorName() string { return "WarehouseArrivalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseArrivalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseArrival.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseArrivalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseArrivalValidationError{}
+
+// Validate checks the field values on WarehouseDeparture with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseDeparture) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseDeparture with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseDepartureMultiError, or nil if none found.
+func (m *WarehouseDeparture) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseDeparture) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseDepartureValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseDepartureValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseDepartureValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseDepartureValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseDepartureValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseDepartureValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseDepartureMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseDepartureMultiError is an error wrapping multiple validation errors
+// returned by WarehouseDeparture.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseDepartureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseDepartureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseDepartureMultiError) AllErrors() []error { return m }
+
+// WarehouseDepartureValidationError is the validation error returned by
+// WarehouseDeparture.Validate if the designated constraints aren't met.
+type WarehouseDepartureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseDepartureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseDepartureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseDepartureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseDepartureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseDepartureValidationError) ErrorName() string {
+	return "WarehouseDepartureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarehouseDepartureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseDeparture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseDepartureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseDepartureValidationError{}
+
+// Validate checks the field values on WarehouseStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WarehouseStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseStatsMultiError,
+// or nil if none found.
+func (m *WarehouseStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseId
+
+	if all {
+		switch v := interface{}(m.GetDwellP50()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP50",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP50",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDwellP50()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsValidationError{
+				field:  "DwellP50",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDwellP90()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP90",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP90",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDwellP90()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsValidationError{
+				field:  "DwellP90",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDwellP99()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP99",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseStatsValidationError{
+					field:  "DwellP99",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDwellP99()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseStatsValidationError{
+				field:  "DwellP99",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InboundUnits
+
+	// no validation rules for OutboundUnits
+
+	// no validation rules for InboundPerHour
+
+	// no validation rules for OutboundPerHour
+
+	// no validation rules for Occupancy
+
+	if len(errors) > 0 {
+		return WarehouseStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseStatsMultiError is an error wrapping multiple validation errors
+// returned by WarehouseStats.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseStatsMultiError) AllErrors() []error { return m }
+
+// WarehouseStatsValidationError is the validation error returned by
+// WarehouseStats.Validate if the designated constraints aren't met.
+type WarehouseStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseStatsValidationError) ErrorName() string { return "WarehouseStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseStatsValidationError{}
+
+// Validate checks the field values on WarehouseVisit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WarehouseVisit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseVisit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseVisitMultiError,
+// or nil if none found.
+func (m *WarehouseVisit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseVisit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArrival()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "Arrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "Arrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArrival()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "Arrival",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetArrivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "ArrivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "ArrivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArrivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "ArrivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDepartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "DepartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "DepartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "DepartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseVisitMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseVisitMultiError is an error wrapping multiple validation errors
+// returned by WarehouseVisit.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseVisitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseVisitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseVisitMultiError) AllErrors() []error { return m }
+
+// WarehouseVisitValidationError is the validation error returned by
+// WarehouseVisit.Validate if the designated constraints aren't met.
+type WarehouseVisitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseVisitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseVisitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseVisitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseVisitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseVisitValidationError) ErrorName() string { return "WarehouseVisitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseVisitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseVisit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseVisitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseVisitValidationError{}
+
+// Validate checks the field values on Location with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Location) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Location with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocationMultiError, or nil
+// if none found.
+func (m *Location) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Location) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLatitude() > 90 {
+		err := LocationValidationError{
+			field:  "Latitude",
+			reason: "value must be less than or equal to 90",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLongitude() > 180 {
+		err := LocationValidationError{
+			field:  "Longitude",
+			reason: "value must be less than or equal to 180",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecordedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "RecordedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "RecordedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecordedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocationValidationError{
+				field:  "RecordedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReceivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocationValidationError{
+				field:  "ReceivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationMultiError is an error wrapping multiple validation errors returned
+// by Location.ValidateAll() if the designated constraints aren't met.
+type LocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationMultiError) AllErrors() []error { return m }
+
+// LocationValidationError is the validation error returned by
+// Location.Validate if the designated constraints aren't met.
+type LocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationValidationError) ErrorName() string { return "LocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationValidationError{}
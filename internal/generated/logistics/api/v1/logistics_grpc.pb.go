@@ -19,9 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	LogisticsEngineAPI_MoveUnit_FullMethodName             = "/logistics.api.v1.LogisticsEngineAPI/MoveUnit"
-	LogisticsEngineAPI_UnitReachedWarehouse_FullMethodName = "/logistics.api.v1.LogisticsEngineAPI/UnitReachedWarehouse"
-	LogisticsEngineAPI_MetricsReport_FullMethodName        = "/logistics.api.v1.LogisticsEngineAPI/MetricsReport"
+	LogisticsEngineAPI_MoveUnit_FullMethodName                  = "/logistics.api.v1.LogisticsEngineAPI/MoveUnit"
+	LogisticsEngineAPI_StreamMoveUnits_FullMethodName           = "/logistics.api.v1.LogisticsEngineAPI/StreamMoveUnits"
+	LogisticsEngineAPI_BatchMoveUnits_FullMethodName            = "/logistics.api.v1.LogisticsEngineAPI/BatchMoveUnits"
+	LogisticsEngineAPI_UnitReachedWarehouse_FullMethodName      = "/logistics.api.v1.LogisticsEngineAPI/UnitReachedWarehouse"
+	LogisticsEngineAPI_BatchUnitReachedWarehouse_FullMethodName = "/logistics.api.v1.LogisticsEngineAPI/BatchUnitReachedWarehouse"
+	LogisticsEngineAPI_UnitDepartedWarehouse_FullMethodName     = "/logistics.api.v1.LogisticsEngineAPI/UnitDepartedWarehouse"
+	LogisticsEngineAPI_GetCargoUnit_FullMethodName              = "/logistics.api.v1.LogisticsEngineAPI/GetCargoUnit"
+	LogisticsEngineAPI_GetCargoUnitTrack_FullMethodName         = "/logistics.api.v1.LogisticsEngineAPI/GetCargoUnitTrack"
+	LogisticsEngineAPI_GetCargoUnitStats_FullMethodName         = "/logistics.api.v1.LogisticsEngineAPI/GetCargoUnitStats"
+	LogisticsEngineAPI_ListCargoUnits_FullMethodName            = "/logistics.api.v1.LogisticsEngineAPI/ListCargoUnits"
+	LogisticsEngineAPI_ListCargoUnitEvents_FullMethodName       = "/logistics.api.v1.LogisticsEngineAPI/ListCargoUnitEvents"
+	LogisticsEngineAPI_WatchCargoUnits_FullMethodName           = "/logistics.api.v1.LogisticsEngineAPI/WatchCargoUnits"
+	LogisticsEngineAPI_MetricsReport_FullMethodName             = "/logistics.api.v1.LogisticsEngineAPI/MetricsReport"
+	LogisticsEngineAPI_WarehouseStats_FullMethodName            = "/logistics.api.v1.LogisticsEngineAPI/WarehouseStats"
 )
 
 // LogisticsEngineAPIClient is the client API for LogisticsEngineAPI service.
@@ -30,10 +41,35 @@ const (
 type LogisticsEngineAPIClient interface {
 	// MoveUnit request will be send when unit moves in dimensions to new location.
 	MoveUnit(ctx context.Context, in *MoveUnitRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// StreamMoveUnits accepts stream of unit moves and applies them in the order they were sent.
+	// Invalid moves and moves failing their if_match are rejected and the stream goes on,
+	// other errors end the stream with their status.
+	StreamMoveUnits(ctx context.Context, opts ...grpc.CallOption) (LogisticsEngineAPI_StreamMoveUnitsClient, error)
+	// BatchMoveUnits applies unit moves buffered by the client, in the order they were sent.
+	BatchMoveUnits(ctx context.Context, in *BatchMoveUnitsRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 	// UnitReachedWarehouse reports when unit reached warehouse to do something there.
 	UnitReachedWarehouse(ctx context.Context, in *UnitReachedWarehouseRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	// MetricsReport reports when .
-	MetricsReport(ctx context.Context, in *DefaultRequest, opts ...grpc.CallOption) (*MetricsReportResponse, error)
+	// BatchUnitReachedWarehouse applies warehouse arrivals buffered by the client, in the order they were sent.
+	BatchUnitReachedWarehouse(ctx context.Context, in *BatchUnitReachedWarehouseRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// UnitDepartedWarehouse reports when unit left the warehouse it is staying at.
+	UnitDepartedWarehouse(ctx context.Context, in *UnitDepartedWarehouseRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// GetCargoUnit returns last known state of the cargo unit.
+	GetCargoUnit(ctx context.Context, in *GetCargoUnitRequest, opts ...grpc.CallOption) (*GetCargoUnitResponse, error)
+	// GetCargoUnitTrack returns every location the cargo unit has been reported at.
+	GetCargoUnitTrack(ctx context.Context, in *GetCargoUnitTrackRequest, opts ...grpc.CallOption) (*GetCargoUnitTrackResponse, error)
+	// GetCargoUnitStats returns distance and speed the cargo unit travelled along its track.
+	GetCargoUnitStats(ctx context.Context, in *GetCargoUnitStatsRequest, opts ...grpc.CallOption) (*GetCargoUnitStatsResponse, error)
+	// ListCargoUnits returns cargo units ordered by id, page by page.
+	ListCargoUnits(ctx context.Context, in *ListCargoUnitsRequest, opts ...grpc.CallOption) (*ListCargoUnitsResponse, error)
+	// ListCargoUnitEvents returns the saved events of the cargo unit ordered by sequence, page by page.
+	// The unit is replayed from them, including every warehouse visit.
+	ListCargoUnitEvents(ctx context.Context, in *ListCargoUnitEventsRequest, opts ...grpc.CallOption) (*ListCargoUnitEventsResponse, error)
+	// WatchCargoUnits streams cargo unit events as they are processed.
+	WatchCargoUnits(ctx context.Context, in *WatchCargoUnitsRequest, opts ...grpc.CallOption) (LogisticsEngineAPI_WatchCargoUnitsClient, error)
+	// MetricsReport reports all-time totals of every unit and series of arrivals and active units over time.
+	MetricsReport(ctx context.Context, in *MetricsReportRequest, opts ...grpc.CallOption) (*MetricsReportResponse, error)
+	// WarehouseStats reports how long units stay at warehouses and how many pass through them.
+	WarehouseStats(ctx context.Context, in *WarehouseStatsRequest, opts ...grpc.CallOption) (*WarehouseStatsResponse, error)
 }
 
 type logisticsEngineAPIClient struct {
@@ -53,6 +89,49 @@ func (c *logisticsEngineAPIClient) MoveUnit(ctx context.Context, in *MoveUnitReq
 	return out, nil
 }
 
+func (c *logisticsEngineAPIClient) StreamMoveUnits(ctx context.Context, opts ...grpc.CallOption) (LogisticsEngineAPI_StreamMoveUnitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogisticsEngineAPI_ServiceDesc.Streams[0], LogisticsEngineAPI_StreamMoveUnits_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logisticsEngineAPIStreamMoveUnitsClient{stream}
+	return x, nil
+}
+
+type LogisticsEngineAPI_StreamMoveUnitsClient interface {
+	Send(*MoveUnitRequest) error
+	CloseAndRecv() (*StreamMoveUnitsResponse, error)
+	grpc.ClientStream
+}
+
+type logisticsEngineAPIStreamMoveUnitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logisticsEngineAPIStreamMoveUnitsClient) Send(m *MoveUnitRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logisticsEngineAPIStreamMoveUnitsClient) CloseAndRecv() (*StreamMoveUnitsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StreamMoveUnitsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logisticsEngineAPIClient) BatchMoveUnits(ctx context.Context, in *BatchMoveUnitsRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_BatchMoveUnits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logisticsEngineAPIClient) UnitReachedWarehouse(ctx context.Context, in *UnitReachedWarehouseRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	out := new(DefaultResponse)
 	err := c.cc.Invoke(ctx, LogisticsEngineAPI_UnitReachedWarehouse_FullMethodName, in, out, opts...)
@@ -62,7 +141,102 @@ func (c *logisticsEngineAPIClient) UnitReachedWarehouse(ctx context.Context, in
 	return out, nil
 }
 
-func (c *logisticsEngineAPIClient) MetricsReport(ctx context.Context, in *DefaultRequest, opts ...grpc.CallOption) (*MetricsReportResponse, error) {
+func (c *logisticsEngineAPIClient) BatchUnitReachedWarehouse(ctx context.Context, in *BatchUnitReachedWarehouseRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_BatchUnitReachedWarehouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) UnitDepartedWarehouse(ctx context.Context, in *UnitDepartedWarehouseRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_UnitDepartedWarehouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) GetCargoUnit(ctx context.Context, in *GetCargoUnitRequest, opts ...grpc.CallOption) (*GetCargoUnitResponse, error) {
+	out := new(GetCargoUnitResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_GetCargoUnit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) GetCargoUnitTrack(ctx context.Context, in *GetCargoUnitTrackRequest, opts ...grpc.CallOption) (*GetCargoUnitTrackResponse, error) {
+	out := new(GetCargoUnitTrackResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_GetCargoUnitTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) GetCargoUnitStats(ctx context.Context, in *GetCargoUnitStatsRequest, opts ...grpc.CallOption) (*GetCargoUnitStatsResponse, error) {
+	out := new(GetCargoUnitStatsResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_GetCargoUnitStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) ListCargoUnits(ctx context.Context, in *ListCargoUnitsRequest, opts ...grpc.CallOption) (*ListCargoUnitsResponse, error) {
+	out := new(ListCargoUnitsResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_ListCargoUnits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) ListCargoUnitEvents(ctx context.Context, in *ListCargoUnitEventsRequest, opts ...grpc.CallOption) (*ListCargoUnitEventsResponse, error) {
+	out := new(ListCargoUnitEventsResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_ListCargoUnitEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logisticsEngineAPIClient) WatchCargoUnits(ctx context.Context, in *WatchCargoUnitsRequest, opts ...grpc.CallOption) (LogisticsEngineAPI_WatchCargoUnitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogisticsEngineAPI_ServiceDesc.Streams[1], LogisticsEngineAPI_WatchCargoUnits_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logisticsEngineAPIWatchCargoUnitsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogisticsEngineAPI_WatchCargoUnitsClient interface {
+	Recv() (*CargoUnitEvent, error)
+	grpc.ClientStream
+}
+
+type logisticsEngineAPIWatchCargoUnitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logisticsEngineAPIWatchCargoUnitsClient) Recv() (*CargoUnitEvent, error) {
+	m := new(CargoUnitEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logisticsEngineAPIClient) MetricsReport(ctx context.Context, in *MetricsReportRequest, opts ...grpc.CallOption) (*MetricsReportResponse, error) {
 	out := new(MetricsReportResponse)
 	err := c.cc.Invoke(ctx, LogisticsEngineAPI_MetricsReport_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -71,16 +245,50 @@ func (c *logisticsEngineAPIClient) MetricsReport(ctx context.Context, in *Defaul
 	return out, nil
 }
 
+func (c *logisticsEngineAPIClient) WarehouseStats(ctx context.Context, in *WarehouseStatsRequest, opts ...grpc.CallOption) (*WarehouseStatsResponse, error) {
+	out := new(WarehouseStatsResponse)
+	err := c.cc.Invoke(ctx, LogisticsEngineAPI_WarehouseStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogisticsEngineAPIServer is the server API for LogisticsEngineAPI service.
 // All implementations should embed UnimplementedLogisticsEngineAPIServer
 // for forward compatibility
 type LogisticsEngineAPIServer interface {
 	// MoveUnit request will be send when unit moves in dimensions to new location.
 	MoveUnit(context.Context, *MoveUnitRequest) (*DefaultResponse, error)
+	// StreamMoveUnits accepts stream of unit moves and applies them in the order they were sent.
+	// Invalid moves and moves failing their if_match are rejected and the stream goes on,
+	// other errors end the stream with their status.
+	StreamMoveUnits(LogisticsEngineAPI_StreamMoveUnitsServer) error
+	// BatchMoveUnits applies unit moves buffered by the client, in the order they were sent.
+	BatchMoveUnits(context.Context, *BatchMoveUnitsRequest) (*BatchResponse, error)
 	// UnitReachedWarehouse reports when unit reached warehouse to do something there.
 	UnitReachedWarehouse(context.Context, *UnitReachedWarehouseRequest) (*DefaultResponse, error)
-	// MetricsReport reports when .
-	MetricsReport(context.Context, *DefaultRequest) (*MetricsReportResponse, error)
+	// BatchUnitReachedWarehouse applies warehouse arrivals buffered by the client, in the order they were sent.
+	BatchUnitReachedWarehouse(context.Context, *BatchUnitReachedWarehouseRequest) (*BatchResponse, error)
+	// UnitDepartedWarehouse reports when unit left the warehouse it is staying at.
+	UnitDepartedWarehouse(context.Context, *UnitDepartedWarehouseRequest) (*DefaultResponse, error)
+	// GetCargoUnit returns last known state of the cargo unit.
+	GetCargoUnit(context.Context, *GetCargoUnitRequest) (*GetCargoUnitResponse, error)
+	// GetCargoUnitTrack returns every location the cargo unit has been reported at.
+	GetCargoUnitTrack(context.Context, *GetCargoUnitTrackRequest) (*GetCargoUnitTrackResponse, error)
+	// GetCargoUnitStats returns distance and speed the cargo unit travelled along its track.
+	GetCargoUnitStats(context.Context, *GetCargoUnitStatsRequest) (*GetCargoUnitStatsResponse, error)
+	// ListCargoUnits returns cargo units ordered by id, page by page.
+	ListCargoUnits(context.Context, *ListCargoUnitsRequest) (*ListCargoUnitsResponse, error)
+	// ListCargoUnitEvents returns the saved events of the cargo unit ordered by sequence, page by page.
+	// The unit is replayed from them, including every warehouse visit.
+	ListCargoUnitEvents(context.Context, *ListCargoUnitEventsRequest) (*ListCargoUnitEventsResponse, error)
+	// WatchCargoUnits streams cargo unit events as they are processed.
+	WatchCargoUnits(*WatchCargoUnitsRequest, LogisticsEngineAPI_WatchCargoUnitsServer) error
+	// MetricsReport reports all-time totals of every unit and series of arrivals and active units over time.
+	MetricsReport(context.Context, *MetricsReportRequest) (*MetricsReportResponse, error)
+	// WarehouseStats reports how long units stay at warehouses and how many pass through them.
+	WarehouseStats(context.Context, *WarehouseStatsRequest) (*WarehouseStatsResponse, error)
 }
 
 // UnimplementedLogisticsEngineAPIServer should be embedded to have forward compatible implementations.
@@ -90,12 +298,45 @@ type UnimplementedLogisticsEngineAPIServer struct {
 func (UnimplementedLogisticsEngineAPIServer) MoveUnit(context.Context, *MoveUnitRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveUnit not implemented")
 }
+func (UnimplementedLogisticsEngineAPIServer) StreamMoveUnits(LogisticsEngineAPI_StreamMoveUnitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMoveUnits not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) BatchMoveUnits(context.Context, *BatchMoveUnitsRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMoveUnits not implemented")
+}
 func (UnimplementedLogisticsEngineAPIServer) UnitReachedWarehouse(context.Context, *UnitReachedWarehouseRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnitReachedWarehouse not implemented")
 }
-func (UnimplementedLogisticsEngineAPIServer) MetricsReport(context.Context, *DefaultRequest) (*MetricsReportResponse, error) {
+func (UnimplementedLogisticsEngineAPIServer) BatchUnitReachedWarehouse(context.Context, *BatchUnitReachedWarehouseRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUnitReachedWarehouse not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) UnitDepartedWarehouse(context.Context, *UnitDepartedWarehouseRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnitDepartedWarehouse not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) GetCargoUnit(context.Context, *GetCargoUnitRequest) (*GetCargoUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCargoUnit not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) GetCargoUnitTrack(context.Context, *GetCargoUnitTrackRequest) (*GetCargoUnitTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCargoUnitTrack not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) GetCargoUnitStats(context.Context, *GetCargoUnitStatsRequest) (*GetCargoUnitStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCargoUnitStats not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) ListCargoUnits(context.Context, *ListCargoUnitsRequest) (*ListCargoUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCargoUnits not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) ListCargoUnitEvents(context.Context, *ListCargoUnitEventsRequest) (*ListCargoUnitEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCargoUnitEvents not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) WatchCargoUnits(*WatchCargoUnitsRequest, LogisticsEngineAPI_WatchCargoUnitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCargoUnits not implemented")
+}
+func (UnimplementedLogisticsEngineAPIServer) MetricsReport(context.Context, *MetricsReportRequest) (*MetricsReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsReport not implemented")
 }
+func (UnimplementedLogisticsEngineAPIServer) WarehouseStats(context.Context, *WarehouseStatsRequest) (*WarehouseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarehouseStats not implemented")
+}
 
 // UnsafeLogisticsEngineAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LogisticsEngineAPIServer will
@@ -126,6 +367,50 @@ func _LogisticsEngineAPI_MoveUnit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogisticsEngineAPI_StreamMoveUnits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogisticsEngineAPIServer).StreamMoveUnits(&logisticsEngineAPIStreamMoveUnitsServer{stream})
+}
+
+type LogisticsEngineAPI_StreamMoveUnitsServer interface {
+	SendAndClose(*StreamMoveUnitsResponse) error
+	Recv() (*MoveUnitRequest, error)
+	grpc.ServerStream
+}
+
+type logisticsEngineAPIStreamMoveUnitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logisticsEngineAPIStreamMoveUnitsServer) SendAndClose(m *StreamMoveUnitsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logisticsEngineAPIStreamMoveUnitsServer) Recv() (*MoveUnitRequest, error) {
+	m := new(MoveUnitRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LogisticsEngineAPI_BatchMoveUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMoveUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).BatchMoveUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_BatchMoveUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).BatchMoveUnits(ctx, req.(*BatchMoveUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogisticsEngineAPI_UnitReachedWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnitReachedWarehouseRequest)
 	if err := dec(in); err != nil {
@@ -144,8 +429,155 @@ func _LogisticsEngineAPI_UnitReachedWarehouse_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogisticsEngineAPI_BatchUnitReachedWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUnitReachedWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).BatchUnitReachedWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_BatchUnitReachedWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).BatchUnitReachedWarehouse(ctx, req.(*BatchUnitReachedWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_UnitDepartedWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitDepartedWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).UnitDepartedWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_UnitDepartedWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).UnitDepartedWarehouse(ctx, req.(*UnitDepartedWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_GetCargoUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCargoUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_GetCargoUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnit(ctx, req.(*GetCargoUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_GetCargoUnitTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCargoUnitTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnitTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_GetCargoUnitTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnitTrack(ctx, req.(*GetCargoUnitTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_GetCargoUnitStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCargoUnitStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnitStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_GetCargoUnitStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).GetCargoUnitStats(ctx, req.(*GetCargoUnitStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_ListCargoUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCargoUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).ListCargoUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_ListCargoUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).ListCargoUnits(ctx, req.(*ListCargoUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_ListCargoUnitEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCargoUnitEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).ListCargoUnitEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_ListCargoUnitEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).ListCargoUnitEvents(ctx, req.(*ListCargoUnitEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_WatchCargoUnits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCargoUnitsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogisticsEngineAPIServer).WatchCargoUnits(m, &logisticsEngineAPIWatchCargoUnitsServer{stream})
+}
+
+type LogisticsEngineAPI_WatchCargoUnitsServer interface {
+	Send(*CargoUnitEvent) error
+	grpc.ServerStream
+}
+
+type logisticsEngineAPIWatchCargoUnitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logisticsEngineAPIWatchCargoUnitsServer) Send(m *CargoUnitEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _LogisticsEngineAPI_MetricsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DefaultRequest)
+	in := new(MetricsReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -157,7 +589,25 @@ func _LogisticsEngineAPI_MetricsReport_Handler(srv interface{}, ctx context.Cont
 		FullMethod: LogisticsEngineAPI_MetricsReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogisticsEngineAPIServer).MetricsReport(ctx, req.(*DefaultRequest))
+		return srv.(LogisticsEngineAPIServer).MetricsReport(ctx, req.(*MetricsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogisticsEngineAPI_WarehouseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogisticsEngineAPIServer).WarehouseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogisticsEngineAPI_WarehouseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogisticsEngineAPIServer).WarehouseStats(ctx, req.(*WarehouseStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -173,15 +623,62 @@ var LogisticsEngineAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveUnit",
 			Handler:    _LogisticsEngineAPI_MoveUnit_Handler,
 		},
+		{
+			MethodName: "BatchMoveUnits",
+			Handler:    _LogisticsEngineAPI_BatchMoveUnits_Handler,
+		},
 		{
 			MethodName: "UnitReachedWarehouse",
 			Handler:    _LogisticsEngineAPI_UnitReachedWarehouse_Handler,
 		},
+		{
+			MethodName: "BatchUnitReachedWarehouse",
+			Handler:    _LogisticsEngineAPI_BatchUnitReachedWarehouse_Handler,
+		},
+		{
+			MethodName: "UnitDepartedWarehouse",
+			Handler:    _LogisticsEngineAPI_UnitDepartedWarehouse_Handler,
+		},
+		{
+			MethodName: "GetCargoUnit",
+			Handler:    _LogisticsEngineAPI_GetCargoUnit_Handler,
+		},
+		{
+			MethodName: "GetCargoUnitTrack",
+			Handler:    _LogisticsEngineAPI_GetCargoUnitTrack_Handler,
+		},
+		{
+			MethodName: "GetCargoUnitStats",
+			Handler:    _LogisticsEngineAPI_GetCargoUnitStats_Handler,
+		},
+		{
+			MethodName: "ListCargoUnits",
+			Handler:    _LogisticsEngineAPI_ListCargoUnits_Handler,
+		},
+		{
+			MethodName: "ListCargoUnitEvents",
+			Handler:    _LogisticsEngineAPI_ListCargoUnitEvents_Handler,
+		},
 		{
 			MethodName: "MetricsReport",
 			Handler:    _LogisticsEngineAPI_MetricsReport_Handler,
 		},
+		{
+			MethodName: "WarehouseStats",
+			Handler:    _LogisticsEngineAPI_WarehouseStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMoveUnits",
+			Handler:       _LogisticsEngineAPI_StreamMoveUnits_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchCargoUnits",
+			Handler:       _LogisticsEngineAPI_WatchCargoUnits_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/logistics.proto",
 }
@@ -0,0 +1,1739 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v2/logistics.proto
+
+package logistics_v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on MoveUnitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveUnitRequestMultiError, or nil if none found.
+func (m *MoveUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := MoveUnitRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocation() == nil {
+		err := MoveUnitRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveUnitRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveUnitRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveUnitRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := MoveUnitRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveUnitRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveUnitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveUnitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveUnitRequestMultiError) AllErrors() []error { return m }
+
+// MoveUnitRequestValidationError is the validation error returned by
+// MoveUnitRequest.Validate if the designated constraints aren't met.
+type MoveUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveUnitRequestValidationError) ErrorName() string { return "MoveUnitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveUnitRequestValidationError{}
+
+// Validate checks the field values on UnitReachedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnitReachedWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitReachedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnitReachedWarehouseRequestMultiError, or nil if none found.
+func (m *UnitReachedWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitReachedWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLocation() == nil {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitReachedWarehouseRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitReachedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitReachedWarehouseRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := UnitReachedWarehouseRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnitReachedWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitReachedWarehouseRequestMultiError is an error wrapping multiple
+// validation errors returned by UnitReachedWarehouseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnitReachedWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitReachedWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitReachedWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// UnitReachedWarehouseRequestValidationError is the validation error returned
+// by UnitReachedWarehouseRequest.Validate if the designated constraints
+// aren't met.
+type UnitReachedWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitReachedWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitReachedWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitReachedWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitReachedWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitReachedWarehouseRequestValidationError) ErrorName() string {
+	return "UnitReachedWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnitReachedWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitReachedWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitReachedWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitReachedWarehouseRequestValidationError{}
+
+// Validate checks the field values on UnitDepartedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnitDepartedWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitDepartedWarehouseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnitDepartedWarehouseRequestMultiError, or nil if none found.
+func (m *UnitDepartedWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitDepartedWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLocation() == nil {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitDepartedWarehouseRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAnnouncement() == nil {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "Announcement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnitDepartedWarehouseRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnitDepartedWarehouseRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIfMatch()) > 32 {
+		err := UnitDepartedWarehouseRequestValidationError{
+			field:  "IfMatch",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnitDepartedWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitDepartedWarehouseRequestMultiError is an error wrapping multiple
+// validation errors returned by UnitDepartedWarehouseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnitDepartedWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitDepartedWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitDepartedWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// UnitDepartedWarehouseRequestValidationError is the validation error returned
+// by UnitDepartedWarehouseRequest.Validate if the designated constraints
+// aren't met.
+type UnitDepartedWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitDepartedWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitDepartedWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitDepartedWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitDepartedWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitDepartedWarehouseRequestValidationError) ErrorName() string {
+	return "UnitDepartedWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnitDepartedWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitDepartedWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitDepartedWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitDepartedWarehouseRequestValidationError{}
+
+// Validate checks the field values on GetCargoUnitTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitTrackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitTrackRequestMultiError, or nil if none found.
+func (m *GetCargoUnitTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := GetCargoUnitTrackRequestValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitTrackRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitTrackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCargoUnitTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitTrackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitTrackRequestMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitTrackRequestValidationError is the validation error returned by
+// GetCargoUnitTrackRequest.Validate if the designated constraints aren't met.
+type GetCargoUnitTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitTrackRequestValidationError) ErrorName() string {
+	return "GetCargoUnitTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitTrackRequestValidationError{}
+
+// Validate checks the field values on DefaultResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DefaultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DefaultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DefaultResponseMultiError, or nil if none found.
+func (m *DefaultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DefaultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DefaultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DefaultResponseMultiError is an error wrapping multiple validation errors
+// returned by DefaultResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DefaultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DefaultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DefaultResponseMultiError) AllErrors() []error { return m }
+
+// DefaultResponseValidationError is the validation error returned by
+// DefaultResponse.Validate if the designated constraints aren't met.
+type DefaultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DefaultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DefaultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DefaultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DefaultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DefaultResponseValidationError) ErrorName() string { return "DefaultResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DefaultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDefaultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DefaultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DefaultResponseValidationError{}
+
+// Validate checks the field values on GetCargoUnitTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCargoUnitTrackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCargoUnitTrackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCargoUnitTrackResponseMultiError, or nil if none found.
+func (m *GetCargoUnitTrackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCargoUnitTrackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CargoUnitId
+
+	for idx, item := range m.GetTrack() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("Track[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("Track[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCargoUnitTrackResponseValidationError{
+					field:  fmt.Sprintf("Track[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "LastLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitTrackResponseValidationError{
+				field:  "LastLocation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWarehouseArrival()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCargoUnitTrackResponseValidationError{
+					field:  "WarehouseArrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWarehouseArrival()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCargoUnitTrackResponseValidationError{
+				field:  "WarehouseArrival",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	for idx, item := range m.GetWarehouseVisits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCargoUnitTrackResponseValidationError{
+						field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCargoUnitTrackResponseValidationError{
+					field:  fmt.Sprintf("WarehouseVisits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCargoUnitTrackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCargoUnitTrackResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCargoUnitTrackResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCargoUnitTrackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCargoUnitTrackResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCargoUnitTrackResponseMultiError) AllErrors() []error { return m }
+
+// GetCargoUnitTrackResponseValidationError is the validation error returned by
+// GetCargoUnitTrackResponse.Validate if the designated constraints aren't met.
+type GetCargoUnitTrackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCargoUnitTrackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCargoUnitTrackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCargoUnitTrackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCargoUnitTrackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCargoUnitTrackResponseValidationError) ErrorName() string {
+	return "GetCargoUnitTrackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCargoUnitTrackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCargoUnitTrackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCargoUnitTrackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCargoUnitTrackResponseValidationError{}
+
+// Validate checks the field values on WarehouseAnnouncement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseAnnouncement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseAnnouncement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseAnnouncementMultiError, or nil if none found.
+func (m *WarehouseAnnouncement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseAnnouncement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCargoUnitId() <= 0 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "CargoUnitId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWarehouseId() <= 0 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "WarehouseId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) > 1024 {
+		err := WarehouseAnnouncementValidationError{
+			field:  "Message",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WarehouseAnnouncementMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseAnnouncementMultiError is an error wrapping multiple validation
+// errors returned by WarehouseAnnouncement.ValidateAll() if the designated
+// constraints aren't met.
+type WarehouseAnnouncementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseAnnouncementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseAnnouncementMultiError) AllErrors() []error { return m }
+
+// WarehouseAnnouncementValidationError is the validation error returned by
+// WarehouseAnnouncement.Validate if the designated constraints aren't met.
+type WarehouseAnnouncementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseAnnouncementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseAnnouncementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseAnnouncementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseAnnouncementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseAnnouncementValidationError) ErrorName() string {
+	return "WarehouseAnnouncementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarehouseAnnouncementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseAnnouncement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseAnnouncementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseAnnouncementValidationError{}
+
+// Validate checks the field values on WarehouseArrival with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WarehouseArrival) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseArrival with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarehouseArrivalMultiError, or nil if none found.
+func (m *WarehouseArrival) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseArrival) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseArrivalValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseArrivalValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseArrivalValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseArrivalMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseArrivalMultiError is an error wrapping multiple validation errors
+// returned by WarehouseArrival.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseArrivalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseArrivalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseArrivalMultiError) AllErrors() []error { return m }
+
+// WarehouseArrivalValidationError is the validation error returned by
+// WarehouseArrival.Validate if the designated constraints aren't met.
+type WarehouseArrivalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseArrivalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseArrivalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseArrivalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseArrivalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseArrivalValidationError) ErrorName() string { return "WarehouseArrivalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseArrivalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseArrival.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseArrivalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseArrivalValidationError{}
+
+// Validate checks the field values on WarehouseVisit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WarehouseVisit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarehouseVisit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseVisitMultiError,
+// or nil if none found.
+func (m *WarehouseVisit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarehouseVisit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArrival()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "Arrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "Arrival",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArrival()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "Arrival",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetArrivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "ArrivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "ArrivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArrivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "ArrivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDepartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "DepartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarehouseVisitValidationError{
+					field:  "DepartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarehouseVisitValidationError{
+				field:  "DepartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WarehouseVisitMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseVisitMultiError is an error wrapping multiple validation errors
+// returned by WarehouseVisit.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseVisitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseVisitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseVisitMultiError) AllErrors() []error { return m }
+
+// WarehouseVisitValidationError is the validation error returned by
+// WarehouseVisit.Validate if the designated constraints aren't met.
+type WarehouseVisitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseVisitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseVisitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseVisitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseVisitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseVisitValidationError) ErrorName() string { return "WarehouseVisitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseVisitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouseVisit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseVisitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseVisitValidationError{}
+
+// Validate checks the field values on Location with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Location) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Location with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocationMultiError, or nil
+// if none found.
+func (m *Location) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Location) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := LocationValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := LocationValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecordedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "RecordedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "RecordedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecordedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocationValidationError{
+				field:  "RecordedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReceivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocationValidationError{
+				field:  "ReceivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Altitude != nil {
+		// no validation rules for Altitude
+	}
+
+	if m.Accuracy != nil {
+
+		if m.GetAccuracy() < 0 {
+			err := LocationValidationError{
+				field:  "Accuracy",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Heading != nil {
+
+		if val := m.GetHeading(); val < 0 || val >= 360 {
+			err := LocationValidationError{
+				field:  "Heading",
+				reason: "value must be inside range [0, 360)",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Speed != nil {
+
+		if m.GetSpeed() < 0 {
+			err := LocationValidationError{
+				field:  "Speed",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationMultiError is an error wrapping multiple validation errors returned
+// by Location.ValidateAll() if the designated constraints aren't met.
+type LocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationMultiError) AllErrors() []error { return m }
+
+// LocationValidationError is the validation error returned by
+// Location.Validate if the designated constraints aren't met.
+type LocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationValidationError) ErrorName() string { return "LocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationValidationError{}
@@ -9,6 +9,7 @@ import (
 
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
 )
 
@@ -18,8 +19,9 @@ type App struct {
 	port       int
 }
 
-// New creates new gRPC server app.
-func New(port int, log *slog.Logger, logisticsEngine grpcserver.LogisticsEngine, srvMetrics *grpcprom.ServerMetrics) *App {
+// New creates new gRPC server app, metricsOpts are passed to metrics interceptors.
+// Incoming W3C trace context is continued, so metrics exemplars carry trace ids of the callers.
+func New(port int, log *slog.Logger, logisticsEngine grpcserver.LogisticsEngine, srvMetrics *grpcprom.ServerMetrics, metricsOpts ...grpcprom.Option) *App {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(
 			//logging.StartCall, logging.FinishCall,
@@ -30,9 +32,17 @@ func New(port int, log *slog.Logger, logisticsEngine grpcserver.LogisticsEngine,
 
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
-			otelgrpc.UnaryServerInterceptor(),
-			srvMetrics.UnaryServerInterceptor(),
+			otelgrpc.UnaryServerInterceptor(otelgrpc.WithPropagators(propagation.TraceContext{})),
+			srvMetrics.UnaryServerInterceptor(metricsOpts...),
 			logging.UnaryServerInterceptor(grpcserver.InterceptorLogger(log), loggingOpts...),
+			grpcserver.UnaryIfMatchInterceptor,
+			grpcserver.UnaryValidationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(otelgrpc.WithPropagators(propagation.TraceContext{})),
+			srvMetrics.StreamServerInterceptor(metricsOpts...),
+			logging.StreamServerInterceptor(grpcserver.InterceptorLogger(log), loggingOpts...),
+			grpcserver.StreamValidationInterceptor,
 		),
 	}
 
@@ -40,6 +50,8 @@ func New(port int, log *slog.Logger, logisticsEngine grpcserver.LogisticsEngine,
 		opts...,
 	)
 	grpcserver.Register(gRPCServer, logisticsEngine)
+	// report zero for every method, unary and streaming, before it is first called
+	srvMetrics.InitializeMetrics(gRPCServer)
 	return &App{
 		log:        log,
 		gRPCServer: gRPCServer,
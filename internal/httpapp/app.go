@@ -3,6 +3,7 @@ package httpapp
 import (
 	"context"
 	"fmt"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
 	"github.com/ivanbulyk/logistics_engine_api/internal/logistics/httpserver"
 	"github.com/prometheus/client_golang/prometheus"
 	"log/slog"
@@ -38,9 +39,9 @@ func (a *App) MustRun() {
 func (a *App) Run() error {
 	const opLabel = "httpapp.Run"
 
-	a.log.Info("metrics server listening at %s\n", slog.String("addr", a.httpAddr))
+	a.log.Info("metrics server listening", slog.String("addr", a.httpAddr))
 	if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		a.log.Info("failed to serve metrics: %v\n", err)
+		a.log.Error("failed to serve metrics", logging.Err(err))
 		return fmt.Errorf("%s: %w", opLabel, err)
 	}
 
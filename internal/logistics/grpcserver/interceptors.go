@@ -7,9 +7,16 @@ import (
 	"strings"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// IfMatchHeader is the metadata key HTTP If-Match header is forwarded by the gateway with
+const IfMatchHeader = "if-match"
+
 // UnaryLogInterceptor logs the endpoints being called.
 func UnaryLogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	log.Println(strings.ToLower(info.FullMethod), "called")
@@ -22,3 +29,58 @@ func InterceptorLogger(l *slog.Logger) logging.Logger {
 		l.Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
+
+// UnaryValidationInterceptor rejects requests violating rules declared in api/v1 and api/v2 logistics.proto with InvalidArgument.
+func UnaryValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(proto.Message); ok {
+		if err := logistics_engine.Validate(msg); err != nil {
+			return nil, logistics_engine.ErrorStatus(err).Err()
+		}
+	}
+	return handler(ctx, req)
+}
+
+// UnaryIfMatchInterceptor sets if_match of requests that have none from If-Match header, so the etag of ETag header
+// can be sent back as it is. It must run before validation.
+func UnaryIfMatchInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(proto.Message); ok {
+		if values := metadata.ValueFromIncomingContext(ctx, IfMatchHeader); len(values) > 0 {
+			setIfMatch(msg.ProtoReflect(), values[0])
+		}
+	}
+	return handler(ctx, req)
+}
+
+// setIfMatch sets if_match field of m unless it is set already, etag is parsed along with if_match sent in the request
+func setIfMatch(m protoreflect.Message, etag string) {
+	fd := m.Descriptor().Fields().ByName("if_match")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || m.Has(fd) {
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfString(etag))
+}
+
+// StreamValidationInterceptor validates request of server-streaming calls.
+// Messages of client-streaming calls are validated one by one by the handler.
+func StreamValidationInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, ss)
+	}
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if err := logistics_engine.Validate(msg); err != nil {
+			return logistics_engine.ErrorStatus(err).Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnaryIfMatchInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []string
+		req    proto.Message
+		want   proto.Message
+	}{
+		{
+			name:   "header sets if_match",
+			header: []string{IfMatchHeader, `"3"`},
+			req:    &logistics_v1.MoveUnitRequest{CargoUnitId: 1},
+			want:   &logistics_v1.MoveUnitRequest{CargoUnitId: 1, IfMatch: `"3"`},
+		},
+		{
+			name:   "header sets if_match of v2 request",
+			header: []string{IfMatchHeader, `"3"`},
+			req:    &logistics_v2.UnitReachedWarehouseRequest{},
+			want:   &logistics_v2.UnitReachedWarehouseRequest{IfMatch: `"3"`},
+		},
+		{
+			name:   "if_match of the request wins",
+			header: []string{IfMatchHeader, `"3"`},
+			req:    &logistics_v1.MoveUnitRequest{CargoUnitId: 1, IfMatch: "2"},
+			want:   &logistics_v1.MoveUnitRequest{CargoUnitId: 1, IfMatch: "2"},
+		},
+		{
+			name: "no header",
+			req:  &logistics_v1.MoveUnitRequest{CargoUnitId: 1},
+			want: &logistics_v1.MoveUnitRequest{CargoUnitId: 1},
+		},
+		{
+			name:   "request without if_match",
+			header: []string{IfMatchHeader, `"3"`},
+			req:    &logistics_v1.GetCargoUnitRequest{CargoUnitId: 1},
+			want:   &logistics_v1.GetCargoUnitRequest{CargoUnitId: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.header...))
+
+			var got interface{}
+			_, err := UnaryIfMatchInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = req
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("UnaryIfMatchInterceptor() error = %v", err)
+			}
+			if !proto.Equal(got.(proto.Message), tt.want) {
+				t.Fatalf("got request %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryValidationInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		code codes.Code
+	}{
+		{name: "valid request", req: &logistics_v1.GetCargoUnitRequest{CargoUnitId: 1}, code: codes.OK},
+		{name: "invalid request", req: &logistics_v1.GetCargoUnitRequest{}, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := false
+			_, err := UnaryValidationInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("UnaryValidationInterceptor() error = %v, want code %v", err, tt.code)
+			}
+			if handled != (tt.code == codes.OK) {
+				t.Fatalf("got handled %v, want %v", handled, tt.code == codes.OK)
+			}
+		})
+	}
+}
+
+// recvStream receives msg
+type recvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestStreamValidationInterceptor(t *testing.T) {
+	invalid := &logistics_v1.MoveUnitRequest{}
+
+	tests := []struct {
+		name string
+		info *grpc.StreamServerInfo
+		code codes.Code
+	}{
+		{name: "server stream request validated", info: &grpc.StreamServerInfo{IsServerStream: true}, code: codes.InvalidArgument},
+		// StreamMoveUnits rejects invalid messages one by one
+		{name: "client stream messages left to handler", info: &grpc.StreamServerInfo{IsClientStream: true}, code: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := StreamValidationInterceptor(nil, &recvStream{msg: invalid}, tt.info, func(srv interface{}, stream grpc.ServerStream) error {
+				return stream.RecvMsg(&logistics_v1.MoveUnitRequest{})
+			})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("StreamValidationInterceptor() error = %v, want code %v", err, tt.code)
+			}
+		})
+	}
+}
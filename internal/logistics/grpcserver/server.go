@@ -2,16 +2,34 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 )
 
 type LogisticsEngine interface {
 	MoveUnit(ctx context.Context, in *logistics_v1.MoveUnitRequest) (*logistics_v1.DefaultResponse, error)
+	BatchMoveUnits(ctx context.Context, in *logistics_v1.BatchMoveUnitsRequest) (*logistics_v1.BatchResponse, error)
 	UnitReachedWarehouse(ctx context.Context, in *logistics_v1.UnitReachedWarehouseRequest) (*logistics_v1.DefaultResponse, error)
-	MetricsReport(ctx context.Context, in *logistics_v1.DefaultRequest) (*logistics_v1.MetricsReportResponse, error)
+	BatchUnitReachedWarehouse(ctx context.Context, in *logistics_v1.BatchUnitReachedWarehouseRequest) (*logistics_v1.BatchResponse, error)
+	UnitDepartedWarehouse(ctx context.Context, in *logistics_v1.UnitDepartedWarehouseRequest) (*logistics_v1.DefaultResponse, error)
+	GetCargoUnit(ctx context.Context, in *logistics_v1.GetCargoUnitRequest) (*logistics_v1.GetCargoUnitResponse, error)
+	GetCargoUnitTrack(ctx context.Context, in *logistics_v1.GetCargoUnitTrackRequest) (*logistics_v1.GetCargoUnitTrackResponse, error)
+	GetCargoUnitStats(ctx context.Context, in *logistics_v1.GetCargoUnitStatsRequest) (*logistics_v1.GetCargoUnitStatsResponse, error)
+	ListCargoUnits(ctx context.Context, in *logistics_v1.ListCargoUnitsRequest) (*logistics_v1.ListCargoUnitsResponse, error)
+	ListCargoUnitEvents(ctx context.Context, in *logistics_v1.ListCargoUnitEventsRequest) (*logistics_v1.ListCargoUnitEventsResponse, error)
+	WatchCargoUnits(ctx context.Context, in *logistics_v1.WatchCargoUnitsRequest, send func(*logistics_v1.CargoUnitEvent) error) error
+	MetricsReport(ctx context.Context, in *logistics_v1.MetricsReportRequest) (*logistics_v1.MetricsReportResponse, error)
+	WarehouseStats(ctx context.Context, in *logistics_v1.WarehouseStatsRequest) (*logistics_v1.WarehouseStatsResponse, error)
+	MoveUnitV2(ctx context.Context, in *logistics_v2.MoveUnitRequest) (*logistics_v2.DefaultResponse, error)
+	UnitReachedWarehouseV2(ctx context.Context, in *logistics_v2.UnitReachedWarehouseRequest) (*logistics_v2.DefaultResponse, error)
+	UnitDepartedWarehouseV2(ctx context.Context, in *logistics_v2.UnitDepartedWarehouseRequest) (*logistics_v2.DefaultResponse, error)
+	GetCargoUnitTrackV2(ctx context.Context, in *logistics_v2.GetCargoUnitTrackRequest) (*logistics_v2.GetCargoUnitTrackResponse, error)
 }
 
 type server struct {
@@ -22,30 +40,181 @@ type server struct {
 func Register(gRPC *grpc.Server, logisticsEngine LogisticsEngine) {
 
 	logistics_v1.RegisterLogisticsEngineAPIServer(gRPC, &server{logisticsEngine: logisticsEngine})
+	logistics_v2.RegisterLogisticsEngineAPIServer(gRPC, &serverV2{logisticsEngine: logisticsEngine})
 }
 
 func (s *server) MoveUnit(ctx context.Context, in *logistics_v1.MoveUnitRequest) (*logistics_v1.DefaultResponse, error) {
 	defaultResponse, err := s.logisticsEngine.MoveUnit(ctx, in)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to process incoming request")
+		return nil, statusError(err, "failed to process incoming request")
 	}
 
 	return defaultResponse, nil
 }
+
+// maxRejectedResults limits results of rejected moves a stream responds with, the rejected moves past it are counted only
+const maxRejectedResults = 100
+
+func (s *server) StreamMoveUnits(stream logistics_v1.LogisticsEngineAPI_StreamMoveUnitsServer) error {
+	resp := &logistics_v1.StreamMoveUnitsResponse{}
+	for index := 0; ; index++ {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		// the validation interceptor leaves messages of client streams to the handler
+		err = logistics_engine.Validate(in)
+		if err == nil {
+			_, err = s.logisticsEngine.MoveUnit(stream.Context(), in)
+		}
+		if err != nil {
+			st := logistics_engine.ErrorStatus(err)
+			if !rejected(st.Code()) {
+				return statusError(err, "failed to process incoming stream")
+			}
+			resp.RejectedNumber++
+			if len(resp.Rejected) >= maxRejectedResults {
+				continue
+			}
+			resp.Rejected = append(resp.Rejected, &logistics_v1.BatchItemResult{
+				Index:       int32(index),
+				CargoUnitId: in.GetCargoUnitId(),
+				Status:      st.Proto(),
+			})
+			continue
+		}
+		resp.AcceptedNumber++
+	}
+}
+
+// rejected reports whether request failed with code can not be applied as it is, so the stream goes on without it.
+// Retryable, internal and context errors end the stream.
+func rejected(code codes.Code) bool {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.Aborted, codes.AlreadyExists:
+		return true
+	}
+	return false
+}
+
+func (s *server) BatchMoveUnits(ctx context.Context, in *logistics_v1.BatchMoveUnitsRequest) (*logistics_v1.BatchResponse, error) {
+	batchResponse, err := s.logisticsEngine.BatchMoveUnits(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming batch")
+	}
+
+	return batchResponse, nil
+}
+
 func (s *server) UnitReachedWarehouse(ctx context.Context, in *logistics_v1.UnitReachedWarehouseRequest) (*logistics_v1.DefaultResponse, error) {
 	defaultResponse, err := s.logisticsEngine.UnitReachedWarehouse(ctx, in)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to process incoming request")
+		return nil, statusError(err, "failed to process incoming request")
+	}
+
+	return defaultResponse, nil
+}
+
+func (s *server) BatchUnitReachedWarehouse(ctx context.Context, in *logistics_v1.BatchUnitReachedWarehouseRequest) (*logistics_v1.BatchResponse, error) {
+	batchResponse, err := s.logisticsEngine.BatchUnitReachedWarehouse(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming batch")
+	}
+
+	return batchResponse, nil
+}
+
+func (s *server) UnitDepartedWarehouse(ctx context.Context, in *logistics_v1.UnitDepartedWarehouseRequest) (*logistics_v1.DefaultResponse, error) {
+	defaultResponse, err := s.logisticsEngine.UnitDepartedWarehouse(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming request")
 	}
 
 	return defaultResponse, nil
 }
 
-func (s *server) MetricsReport(ctx context.Context, in *logistics_v1.DefaultRequest) (*logistics_v1.MetricsReportResponse, error) {
+func (s *server) GetCargoUnit(ctx context.Context, in *logistics_v1.GetCargoUnitRequest) (*logistics_v1.GetCargoUnitResponse, error) {
+	getCargoUnitResponse, err := s.logisticsEngine.GetCargoUnit(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo unit")
+	}
+
+	return getCargoUnitResponse, nil
+}
+
+func (s *server) GetCargoUnitTrack(ctx context.Context, in *logistics_v1.GetCargoUnitTrackRequest) (*logistics_v1.GetCargoUnitTrackResponse, error) {
+	getCargoUnitTrackResponse, err := s.logisticsEngine.GetCargoUnitTrack(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo unit track")
+	}
+
+	return getCargoUnitTrackResponse, nil
+}
+
+func (s *server) GetCargoUnitStats(ctx context.Context, in *logistics_v1.GetCargoUnitStatsRequest) (*logistics_v1.GetCargoUnitStatsResponse, error) {
+	getCargoUnitStatsResponse, err := s.logisticsEngine.GetCargoUnitStats(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo unit stats")
+	}
+
+	return getCargoUnitStatsResponse, nil
+}
+
+func (s *server) ListCargoUnits(ctx context.Context, in *logistics_v1.ListCargoUnitsRequest) (*logistics_v1.ListCargoUnitsResponse, error) {
+	listCargoUnitsResponse, err := s.logisticsEngine.ListCargoUnits(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo units")
+	}
+
+	return listCargoUnitsResponse, nil
+}
+
+func (s *server) ListCargoUnitEvents(ctx context.Context, in *logistics_v1.ListCargoUnitEventsRequest) (*logistics_v1.ListCargoUnitEventsResponse, error) {
+	listCargoUnitEventsResponse, err := s.logisticsEngine.ListCargoUnitEvents(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo unit events")
+	}
+
+	return listCargoUnitEventsResponse, nil
+}
+
+func (s *server) WatchCargoUnits(in *logistics_v1.WatchCargoUnitsRequest, stream logistics_v1.LogisticsEngineAPI_WatchCargoUnitsServer) error {
+	err := s.logisticsEngine.WatchCargoUnits(stream.Context(), in, stream.Send)
+	if err != nil {
+		return statusError(err, "failed to stream cargo unit events")
+	}
+
+	return nil
+}
+
+func (s *server) MetricsReport(ctx context.Context, in *logistics_v1.MetricsReportRequest) (*logistics_v1.MetricsReportResponse, error) {
 	metricsReportResponse, err := s.logisticsEngine.MetricsReport(ctx, in)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to process response with metrics report")
+		return nil, statusError(err, "failed to process response with metrics report")
 	}
 
 	return metricsReportResponse, nil
 }
+
+func (s *server) WarehouseStats(ctx context.Context, in *logistics_v1.WarehouseStatsRequest) (*logistics_v1.WarehouseStatsResponse, error) {
+	warehouseStatsResponse, err := s.logisticsEngine.WarehouseStats(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with warehouse stats")
+	}
+
+	return warehouseStatsResponse, nil
+}
+
+// statusError converts err into gRPC status error, hiding unexpected errors behind msg.
+func statusError(err error, msg string) error {
+	st := logistics_engine.ErrorStatus(err)
+	if st.Code() == codes.Internal {
+		return status.Error(codes.Internal, msg)
+	}
+
+	return st.Err()
+}
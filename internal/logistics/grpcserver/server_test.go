@@ -0,0 +1,147 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// moveUnitEngine fails MoveUnit with the error of the cargo unit, if any
+type moveUnitEngine struct {
+	LogisticsEngine
+	errs map[int64]error
+}
+
+func (e *moveUnitEngine) MoveUnit(ctx context.Context, in *logistics_v1.MoveUnitRequest) (*logistics_v1.DefaultResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := e.errs[in.GetCargoUnitId()]; err != nil {
+		return nil, err
+	}
+	return &logistics_v1.DefaultResponse{}, nil
+}
+
+// moveUnitsStream receives requests and keeps the response it is closed with
+type moveUnitsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*logistics_v1.MoveUnitRequest
+	resp     *logistics_v1.StreamMoveUnitsResponse
+}
+
+func (s *moveUnitsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *moveUnitsStream) Recv() (*logistics_v1.MoveUnitRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	in := s.requests[0]
+	s.requests = s.requests[1:]
+	return in, nil
+}
+
+func (s *moveUnitsStream) SendAndClose(resp *logistics_v1.StreamMoveUnitsResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestStreamMoveUnits(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// move of unit 0 is invalid, unit 3 fails a precondition, unit 6 its etag, unit 4 hits unavailable storage
+	// and unit 5 an unexpected error
+	srv := &server{logisticsEngine: &moveUnitEngine{errs: map[int64]error{
+		3: logistics_engine.ErrNotAtWarehouse,
+		6: repository.ErrConflict,
+		4: repository.ErrUnavailable,
+		5: io.ErrUnexpectedEOF,
+	}}}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		units        []int64
+		code         codes.Code
+		accepted     int64
+		rejectedUnit map[int32]int64
+	}{
+		{name: "every move accepted", units: []int64{1, 1}, accepted: 2},
+		{name: "invalid moves rejected", units: []int64{0, 1, 3, 1, 6}, accepted: 2, rejectedUnit: map[int32]int64{0: 0, 2: 3, 4: 6}},
+		{name: "unavailable storage ends stream", units: []int64{1, 4, 1}, code: codes.Unavailable},
+		{name: "internal error ends stream", units: []int64{1, 5, 1}, code: codes.Internal},
+		{name: "canceled stream ends", ctx: canceled, units: []int64{1, 1}, code: codes.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &moveUnitsStream{ctx: tt.ctx}
+			if stream.ctx == nil {
+				stream.ctx = context.Background()
+			}
+			for _, id := range tt.units {
+				stream.requests = append(stream.requests, &logistics_v1.MoveUnitRequest{CargoUnitId: id, Location: &logistics_v1.Location{Latitude: 1}})
+			}
+
+			err := srv.StreamMoveUnits(stream)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("StreamMoveUnits() error = %v, want code %v", err, tt.code)
+			}
+			if tt.code != codes.OK {
+				if stream.resp != nil {
+					t.Fatalf("got response %v of failed stream, want none", stream.resp)
+				}
+				return
+			}
+
+			if stream.resp.GetAcceptedNumber() != tt.accepted || stream.resp.GetRejectedNumber() != int64(len(tt.rejectedUnit)) {
+				t.Fatalf("got %d accepted and %d rejected, want %d and %d",
+					stream.resp.GetAcceptedNumber(), stream.resp.GetRejectedNumber(), tt.accepted, len(tt.rejectedUnit))
+			}
+			if len(stream.resp.GetRejected()) != len(tt.rejectedUnit) {
+				t.Fatalf("got rejected %v, want %d results", stream.resp.GetRejected(), len(tt.rejectedUnit))
+			}
+			for _, result := range stream.resp.GetRejected() {
+				unit, exist := tt.rejectedUnit[result.GetIndex()]
+				if !exist || unit != result.GetCargoUnitId() || result.GetStatus().GetCode() == int32(codes.OK) {
+					t.Fatalf("got rejected result %v, want unit %d failed", result, tt.rejectedUnit[result.GetIndex()])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamMoveUnitsRejectedLimit(t *testing.T) {
+	srv := &server{logisticsEngine: &moveUnitEngine{}}
+
+	// every other move is invalid, results of the rejected moves past the limit are left out
+	stream := &moveUnitsStream{ctx: context.Background()}
+	for i := 0; i < 2*maxRejectedResults+10; i++ {
+		stream.requests = append(stream.requests, &logistics_v1.MoveUnitRequest{CargoUnitId: int64(i % 2), Location: &logistics_v1.Location{Latitude: 1}})
+	}
+
+	if err := srv.StreamMoveUnits(stream); err != nil {
+		t.Fatalf("StreamMoveUnits() error = %v", err)
+	}
+	if stream.resp.GetAcceptedNumber() != maxRejectedResults+5 || stream.resp.GetRejectedNumber() != maxRejectedResults+5 {
+		t.Fatalf("got %d accepted and %d rejected, want %d of both",
+			stream.resp.GetAcceptedNumber(), stream.resp.GetRejectedNumber(), maxRejectedResults+5)
+	}
+	if len(stream.resp.GetRejected()) != maxRejectedResults {
+		t.Fatalf("got %d rejected results, want %d", len(stream.resp.GetRejected()), maxRejectedResults)
+	}
+	for i, result := range stream.resp.GetRejected() {
+		if result.GetIndex() != int32(2*i) {
+			t.Fatalf("got rejected result %v at %d, want move %d", result, i, 2*i)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package grpcserver
+
+import (
+	"context"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+)
+
+type serverV2 struct {
+	logistics_v2.UnimplementedLogisticsEngineAPIServer
+	logisticsEngine LogisticsEngine
+}
+
+func (s *serverV2) MoveUnit(ctx context.Context, in *logistics_v2.MoveUnitRequest) (*logistics_v2.DefaultResponse, error) {
+	defaultResponse, err := s.logisticsEngine.MoveUnitV2(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming request")
+	}
+
+	return defaultResponse, nil
+}
+
+func (s *serverV2) UnitReachedWarehouse(ctx context.Context, in *logistics_v2.UnitReachedWarehouseRequest) (*logistics_v2.DefaultResponse, error) {
+	defaultResponse, err := s.logisticsEngine.UnitReachedWarehouseV2(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming request")
+	}
+
+	return defaultResponse, nil
+}
+
+func (s *serverV2) UnitDepartedWarehouse(ctx context.Context, in *logistics_v2.UnitDepartedWarehouseRequest) (*logistics_v2.DefaultResponse, error) {
+	defaultResponse, err := s.logisticsEngine.UnitDepartedWarehouseV2(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process incoming request")
+	}
+
+	return defaultResponse, nil
+}
+
+func (s *serverV2) GetCargoUnitTrack(ctx context.Context, in *logistics_v2.GetCargoUnitTrackRequest) (*logistics_v2.GetCargoUnitTrackResponse, error) {
+	getCargoUnitTrackResponse, err := s.logisticsEngine.GetCargoUnitTrackV2(ctx, in)
+	if err != nil {
+		return nil, statusError(err, "failed to process response with cargo unit track")
+	}
+
+	return getCargoUnitTrackResponse, nil
+}
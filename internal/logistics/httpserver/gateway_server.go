@@ -0,0 +1,91 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/textproto"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logistics/grpcserver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewGatewayServer returns HTTP server translating REST/JSON calls into gRPC calls made over conn.
+func NewGatewayServer(httpAddr string, log *slog.Logger, conn *grpc.ClientConn) (*http.Server, error) {
+	const opLabel = "httpserver.NewGatewayServer"
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithErrorHandler(gatewayErrorHandler(log)),
+		runtime.WithForwardResponseOption(etagHeader),
+		runtime.WithIncomingHeaderMatcher(ifMatchHeader),
+	)
+
+	if err := logistics_v1.RegisterLogisticsEngineAPIHandler(context.Background(), mux, conn); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+	if err := logistics_v2.RegisterLogisticsEngineAPIHandler(context.Background(), mux, conn); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &http.Server{Addr: httpAddr, Handler: mux}, nil
+}
+
+// etagHeader sets HTTP ETag header of responses carrying cargo unit etag.
+func etagHeader(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	var etag string
+	switch m := msg.(type) {
+	case *logistics_v1.GetCargoUnitResponse:
+		etag = m.GetCargoUnit().GetEtag()
+	case *logistics_v1.GetCargoUnitTrackResponse:
+		etag = m.GetEtag()
+	case *logistics_v2.GetCargoUnitTrackResponse:
+		etag = m.GetEtag()
+	}
+	if etag != "" {
+		w.Header().Set("ETag", strconv.Quote(etag))
+	}
+	return nil
+}
+
+// ifMatchHeader forwards HTTP If-Match header as if_match of the request, other headers are forwarded the default way.
+func ifMatchHeader(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "If-Match" {
+		return grpcserver.IfMatchHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayErrorHandler logs failed calls and maps gRPC status codes to HTTP status codes.
+func gatewayErrorHandler(log *slog.Logger) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		st := status.Convert(err)
+
+		log.Warn("gateway request failed",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("code", st.Code().String()),
+			slog.Int("http_status", runtime.HTTPStatusFromCode(st.Code())),
+			logging.Err(err),
+		)
+
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+	}
+}
@@ -0,0 +1,164 @@
+package httpserver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/broker"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logistics/grpcserver"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/memory"
+	"github.com/ivanbulyk/logistics_engine_api/internal/services/logistics_engine"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestGateway returns handler of the gateway calling gRPC server of the service over memory repository
+func newTestGateway(t *testing.T) http.Handler {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	lis := bufconn.Listen(1 << 20)
+	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(grpcserver.UnaryIfMatchInterceptor, grpcserver.UnaryValidationInterceptor))
+	repository := memory.New()
+	grpcserver.Register(gRPCServer, logistics_engine.NewLogisticsEngine(log, repository, repository, broker.New(1)))
+	go gRPCServer.Serve(lis)
+	t.Cleanup(gRPCServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	srv, err := NewGatewayServer(":0", log, conn)
+	if err != nil {
+		t.Fatalf("NewGatewayServer() error = %v", err)
+	}
+	return srv.Handler
+}
+
+func TestGatewayServer(t *testing.T) {
+	handler := newTestGateway(t)
+
+	// requests are sent in order, the unit moved by the first one is read by the later ones
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		ifMatch string
+		code    int
+		etag    string
+		want    string
+	}{
+		{
+			name:   "move unit",
+			method: http.MethodPost,
+			path:   "/v1/cargo_unit/move",
+			body:   `{"cargo_unit_id": 1, "location": {"Latitude": 1, "Longitude": 2}}`,
+			code:   http.StatusOK,
+		},
+		{
+			name:   "get unit",
+			method: http.MethodGet,
+			path:   "/v1/cargo_unit/1",
+			code:   http.StatusOK,
+			etag:   `"1"`,
+			want:   `"cargo_unit_id":"1"`,
+		},
+		{
+			name:   "get track",
+			method: http.MethodGet,
+			path:   "/v1/cargo_unit/1/track",
+			code:   http.StatusOK,
+			etag:   `"1"`,
+			want:   `"Longitude":2`,
+		},
+		{
+			name:    "move matching etag",
+			method:  http.MethodPost,
+			path:    "/v1/cargo_unit/move",
+			body:    `{"cargo_unit_id": 1, "location": {"Latitude": 2, "Longitude": 3}}`,
+			ifMatch: `"1"`,
+			code:    http.StatusOK,
+		},
+		{
+			name:    "move stale etag",
+			method:  http.MethodPost,
+			path:    "/v1/cargo_unit/move",
+			body:    `{"cargo_unit_id": 1, "location": {"Latitude": 3, "Longitude": 4}}`,
+			ifMatch: `"1"`,
+			code:    http.StatusConflict,
+		},
+		{
+			name:   "invalid move",
+			method: http.MethodPost,
+			path:   "/v1/cargo_unit/move",
+			body:   `{"cargo_unit_id": 0, "location": {"Latitude": 1, "Longitude": 2}}`,
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "unknown unit",
+			method: http.MethodGet,
+			path:   "/v1/cargo_unit/2",
+			code:   http.StatusNotFound,
+		},
+		{
+			name:   "report",
+			method: http.MethodPost,
+			path:   "/v1/report",
+			body:   `{}`,
+			code:   http.StatusOK,
+			want:   `"delivery_units_number":"1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.code {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.code, rec.Body)
+			}
+			if etag := rec.Header().Get("ETag"); etag != tt.etag {
+				t.Fatalf("got ETag %q, want %q", etag, tt.etag)
+			}
+			if !strings.Contains(rec.Body.String(), tt.want) {
+				t.Fatalf("got body %s, want it to contain %s", rec.Body, tt.want)
+			}
+		})
+	}
+}
+
+func TestIfMatchHeader(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+		ok   bool
+	}{
+		{key: "If-Match", want: grpcserver.IfMatchHeader, ok: true},
+		{key: "if-match", want: grpcserver.IfMatchHeader, ok: true},
+		{key: "Authorization", want: "grpcgateway-Authorization", ok: true},
+		{key: "X-Custom", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := ifMatchHeader(tt.key)
+		if got != tt.want || ok != tt.ok {
+			t.Fatalf("ifMatchHeader(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.ok)
+		}
+	}
+}
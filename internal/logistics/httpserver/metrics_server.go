@@ -10,7 +10,10 @@ import (
 func NewMetricsServer(httpAddr string, reg *prometheus.Registry) *http.Server {
 	httpSrv := &http.Server{Addr: httpAddr}
 	m := http.NewServeMux()
-	m.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	m.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{
+		// exemplars are served in OpenMetrics format only
+		EnableOpenMetrics: true,
+	}))
 	httpSrv.Handler = m
 	return httpSrv
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
 type MoveUnit struct {
 	CargoUnitId int64      `json:"cargo_unit_id"`
 	Location    []Location `json:"location"`
@@ -11,6 +17,22 @@ type UnitReachedWarehouse struct {
 	Announcement WarehouseAnnouncement `json:"announcement"`
 }
 
+// Equal reports whether u and o describe the same arrival
+func (u UnitReachedWarehouse) Equal(o UnitReachedWarehouse) bool {
+	return u.Location.Equal(o.Location) && u.Announcement == o.Announcement
+}
+
+// UnitDepartedWarehouse contains WarehouseAnnouncement of the warehouse the unit left with Location
+type UnitDepartedWarehouse struct {
+	Location     Location              `json:"location"`
+	Announcement WarehouseAnnouncement `json:"announcement"`
+}
+
+// Equal reports whether u and o describe the same departure
+func (u UnitDepartedWarehouse) Equal(o UnitDepartedWarehouse) bool {
+	return u.Location.Equal(o.Location) && u.Announcement == o.Announcement
+}
+
 type WarehouseAnnouncement struct {
 	// cargo_unit_id is unique id
 	CargoUnitId int64 `json:"cargo_unit_id"`
@@ -20,17 +42,150 @@ type WarehouseAnnouncement struct {
 	Message string `json:"message"`
 }
 
-// Location where entity now located in X,Y Axis
+// WarehouseVisit is a stay of the unit at a warehouse
+type WarehouseVisit struct {
+	UnitReachedWarehouse UnitReachedWarehouse `json:"unit_reached_warehouse"`
+	ArrivedAt            time.Time            `json:"arrived_at"`
+	// DepartedAt is zero while the unit stays at the warehouse
+	DepartedAt time.Time `json:"departed_at"`
+}
+
+// Dwell returns the time the unit stayed at the warehouse, 0 unless the unit has departed
+func (v WarehouseVisit) Dwell() time.Duration {
+	if v.ArrivedAt.IsZero() || v.DepartedAt.IsZero() {
+		return 0
+	}
+	return v.DepartedAt.Sub(v.ArrivedAt)
+}
+
+// Equal reports whether v and o describe the same visit at the same instants
+func (v WarehouseVisit) Equal(o WarehouseVisit) bool {
+	return v.UnitReachedWarehouse.Equal(o.UnitReachedWarehouse) && v.ArrivedAt.Equal(o.ArrivedAt) && v.DepartedAt.Equal(o.DepartedAt)
+}
+
+// Location where entity now located, in WGS84 degrees
 type Location struct {
-	Latitude  uint32 `json:"latitude"`
-	Longitude uint32 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Altitude is the height above the WGS84 ellipsoid in meters, nil if the device did not report it
+	Altitude *float64 `json:"altitude,omitempty"`
+	// Accuracy is the radius of uncertainty of the location in meters, nil if the device did not report it
+	Accuracy *float64 `json:"accuracy,omitempty"`
+	// Heading is the direction of travel in degrees clockwise from true north, nil if the device did not report it
+	Heading *float64 `json:"heading,omitempty"`
+	// Speed is the ground speed in meters per second, nil if the device did not report it
+	Speed *float64 `json:"speed,omitempty"`
+	// RecordedAt is the time the device was at the location, zero if the device did not report it
+	RecordedAt time.Time `json:"recorded_at"`
+	// ReceivedAt is the time the server received the location
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// Time returns the time the unit was at the location, preferring the time reported by the device
+func (l Location) Time() time.Time {
+	if !l.RecordedAt.IsZero() {
+		return l.RecordedAt
+	}
+	return l.ReceivedAt
+}
+
+// Equal reports whether l and o are the same point reported at the same instants
+func (l Location) Equal(o Location) bool {
+	return l.Latitude == o.Latitude && l.Longitude == o.Longitude &&
+		equalOptional(l.Altitude, o.Altitude) && equalOptional(l.Accuracy, o.Accuracy) &&
+		equalOptional(l.Heading, o.Heading) && equalOptional(l.Speed, o.Speed) &&
+		l.RecordedAt.Equal(o.RecordedAt) && l.ReceivedAt.Equal(o.ReceivedAt)
+}
+
+// equalOptional reports whether a and o are both unset or hold the same value
+func equalOptional(a, o *float64) bool {
+	if a == nil || o == nil {
+		return a == o
+	}
+	return *a == *o
 }
 
 type MetricsReport struct {
-	ID                   int64                `json:"report_id"`
-	MoveUnit             MoveUnit             `json:"move_unit"`
+	ID       int64    `json:"report_id"`
+	MoveUnit MoveUnit `json:"move_unit"`
+	// UnitReachedWarehouse is the last warehouse arrival of the unit
 	UnitReachedWarehouse UnitReachedWarehouse `json:"unit_reached_warehouse"`
+	// WarehouseVisits lists every warehouse the unit has reached in the order of arrival
+	WarehouseVisits []WarehouseVisit `json:"warehouse_visits"`
+	// LastSeenAt is the time the last event of the unit was received
+	LastSeenAt time.Time `json:"last_seen_at"`
+	// Version is incremented by the repository on every write
+	Version int64 `json:"version"`
+}
+
+// ReachedWarehouse reports whether unit has reached warehouse
+func (r MetricsReport) ReachedWarehouse() bool {
+	return r.UnitReachedWarehouse.Announcement.CargoUnitId != 0
+}
+
+// Visits returns warehouse visits of the unit, falling back to the last arrival
+// for reports saved without visits
+func (r MetricsReport) Visits() []WarehouseVisit {
+	if len(r.WarehouseVisits) == 0 && r.ReachedWarehouse() {
+		return []WarehouseVisit{{UnitReachedWarehouse: r.UnitReachedWarehouse}}
+	}
+	return r.WarehouseVisits
+}
+
+// Clone returns a copy of the report that shares no slices with r, so it can be changed while r is read
+func (r MetricsReport) Clone() MetricsReport {
+	r.MoveUnit.Location = slices.Clone(r.MoveUnit.Location)
+	r.WarehouseVisits = slices.Clone(r.WarehouseVisits)
+	return r
 }
+
+// Head returns the part of the report events are decided on: the report without its track and with the last
+// warehouse visit only. Events change no earlier visit but the arrivals older than the last visit, see LateArrival.
+// Head shares no slices with r.
+func (r MetricsReport) Head() MetricsReport {
+	r.MoveUnit = MoveUnit{}
+	if n := len(r.WarehouseVisits); n > 0 {
+		r.WarehouseVisits = []WarehouseVisit{r.WarehouseVisits[n-1]}
+	}
+	return r
+}
+
+type CargoUnitStatus int
+
+const (
+	CargoUnitStatusAny CargoUnitStatus = iota
+	CargoUnitStatusInTransit
+	CargoUnitStatusArrived
+)
+
+// CargoUnitsFilter selects reports, zero value fields match every report
+type CargoUnitsFilter struct {
+	WarehouseId   int64           `json:"warehouse_id"`
+	Status        CargoUnitStatus `json:"status"`
+	LastSeenAfter time.Time       `json:"last_seen_after"`
+}
+
+// Match reports whether report satisfies every filter condition
+func (f CargoUnitsFilter) Match(r MetricsReport) bool {
+	if f.WarehouseId != 0 && (!r.ReachedWarehouse() || r.UnitReachedWarehouse.Announcement.WarehouseId != f.WarehouseId) {
+		return false
+	}
+	switch f.Status {
+	case CargoUnitStatusInTransit:
+		if r.ReachedWarehouse() {
+			return false
+		}
+	case CargoUnitStatusArrived:
+		if !r.ReachedWarehouse() {
+			return false
+		}
+	}
+	if !f.LastSeenAfter.IsZero() && !r.LastSeenAt.After(f.LastSeenAfter) {
+		return false
+	}
+	return true
+}
+
 type DeliveryUnitsWarehouseReceivedTotalNumber struct {
 	WarehouseId         int64 `json:"warehouse_id"`
 	DeliveryUnitsNumber int64 `json:"delivery_units_number"`
@@ -40,5 +195,353 @@ type Report struct {
 	DeliveryUnitsTotalNumber                      int64                                       `json:"delivery_units_total_number"`
 	WarehousesReceivedSuppliesList                []int64                                     `json:"warehouses_received_supplies_list"`
 	DeliveryUnitsReachedDestination               []int64                                     `json:"delivery_units_reached_destination"`
+	InTransitUnits                                []int64                                     `json:"in_transit_units"`
 	DeliveryUnitsEachWarehouseReceivedTotalNumber []DeliveryUnitsWarehouseReceivedTotalNumber `json:"delivery_units_each_warehouse_received_total_number"`
+	// TravelStats adds up travel stats of every unit
+	TravelStats TravelStats `json:"travel_stats"`
+}
+
+// RepositorySize counts units kept by the repository and their events
+type RepositorySize struct {
+	CargoUnits     int64 `json:"cargo_units"`
+	InTransitUnits int64 `json:"in_transit_units"`
+	Events         int64 `json:"events"`
+}
+
+// TravelStats describes the path units travelled along their tracks, stats of several units add up
+type TravelStats struct {
+	// DistanceMeters is the length of the track
+	DistanceMeters float64 `json:"distance_meters"`
+	// StraightLineMeters is the distance between the first and the last location of the track
+	StraightLineMeters float64 `json:"straight_line_meters"`
+	// TimedDistanceMeters and TimedSeconds cover only segments of the track between locations of increasing time,
+	// speeds are computed from them
+	TimedDistanceMeters float64 `json:"timed_distance_meters"`
+	TimedSeconds        float64 `json:"timed_seconds"`
+	// MaxSpeed is the highest speed over a timed segment in meters per second
+	MaxSpeed float64 `json:"max_speed"`
+}
+
+// AverageSpeed returns the average speed over timed segments in meters per second, 0 if there are none
+func (s TravelStats) AverageSpeed() float64 {
+	if s.TimedSeconds == 0 {
+		return 0
+	}
+	return s.TimedDistanceMeters / s.TimedSeconds
+}
+
+// PathRatio returns how many times the track is longer than the straight line, 0 if the track ends where it starts
+func (s TravelStats) PathRatio() float64 {
+	if s.StraightLineMeters == 0 {
+		return 0
+	}
+	return s.DistanceMeters / s.StraightLineMeters
+}
+
+// Add returns stats of the units of both s and o
+func (s TravelStats) Add(o TravelStats) TravelStats {
+	return TravelStats{
+		DistanceMeters:      s.DistanceMeters + o.DistanceMeters,
+		StraightLineMeters:  s.StraightLineMeters + o.StraightLineMeters,
+		TimedDistanceMeters: s.TimedDistanceMeters + o.TimedDistanceMeters,
+		TimedSeconds:        s.TimedSeconds + o.TimedSeconds,
+		MaxSpeed:            max(s.MaxSpeed, o.MaxSpeed),
+	}
+}
+
+type CargoUnitEventType int
+
+const (
+	CargoUnitEventMoved CargoUnitEventType = iota + 1
+	CargoUnitEventReachedWarehouse
+	CargoUnitEventDepartedWarehouse
+)
+
+// CargoUnitEvent is emitted every time cargo unit data is processed, events of the unit are never changed once saved
+type CargoUnitEvent struct {
+	Type                  CargoUnitEventType    `json:"type"`
+	CargoUnitId           int64                 `json:"cargo_unit_id"`
+	Location              Location              `json:"location"`
+	UnitReachedWarehouse  UnitReachedWarehouse  `json:"unit_reached_warehouse"`
+	UnitDepartedWarehouse UnitDepartedWarehouse `json:"unit_departed_warehouse"`
+	// OccurredAt is the time of the event reported by the device, or ReceivedAt if the device did not report it
+	OccurredAt time.Time `json:"occurred_at"`
+	// ReceivedAt is the time the server received the event
+	ReceivedAt time.Time `json:"received_at"`
+	// Sequence is the version of the unit report right after the event was applied, it grows with every event of the unit
+	Sequence int64 `json:"sequence"`
+}
+
+// Apply changes report the way event describes, report is a projection of the events of the unit.
+// Apply changes slices of the report in place, a report shared with readers must be cloned first.
+func (r *MetricsReport) Apply(event CargoUnitEvent) {
+	switch event.Type {
+	case CargoUnitEventMoved:
+		r.MoveUnit.CargoUnitId = event.CargoUnitId
+		r.MoveUnit.Location = insertByTime(r.MoveUnit.Location, event.Location)
+		r.depart(event.OccurredAt)
+	case CargoUnitEventReachedWarehouse:
+		r.arrive(event.OccurredAt, event.UnitReachedWarehouse)
+	case CargoUnitEventDepartedWarehouse:
+		if r.StayingAt(event.UnitDepartedWarehouse.Announcement.WarehouseId) {
+			r.depart(event.OccurredAt)
+		}
+	}
+	r.ID = event.CargoUnitId
+	r.LastSeenAt = event.ReceivedAt
+	if r.LastSeenAt.IsZero() {
+		// events saved before ReceivedAt was introduced occurred at the time they were received
+		r.LastSeenAt = event.OccurredAt
+	}
+	r.Version = event.Sequence
+}
+
+// insertByTime inserts location into track ordered by Location.Time, after the locations of the same time,
+// so late locations take their place in the track. Locations without time are appended.
+func insertByTime(track []Location, location Location) []Location {
+	i := len(track)
+	if t := location.Time(); !t.IsZero() {
+		for i > 0 && track[i-1].Time().After(t) {
+			i--
+		}
+	}
+	return slices.Insert(track, i, location)
+}
+
+// StayingAt reports whether the unit has arrived at the warehouse and not departed from it yet
+func (r MetricsReport) StayingAt(warehouseID int64) bool {
+	n := len(r.WarehouseVisits)
+	return n > 0 && r.WarehouseVisits[n-1].DepartedAt.IsZero() && r.WarehouseVisits[n-1].UnitReachedWarehouse.Announcement.WarehouseId == warehouseID
+}
+
+// LateArrival returns the earliest time events reach a warehouse at before the last visit of the report began,
+// false if there is no such arrival. Late arrivals are inserted among the visits from the one before the time.
+func (r MetricsReport) LateArrival(events []CargoUnitEvent) (time.Time, bool) {
+	n := len(r.WarehouseVisits)
+	if n == 0 {
+		return time.Time{}, false
+	}
+	var at time.Time
+	for _, event := range events {
+		if event.Type != CargoUnitEventReachedWarehouse || event.OccurredAt.IsZero() {
+			continue
+		}
+		if event.OccurredAt.Before(r.WarehouseVisits[n-1].ArrivedAt) && (at.IsZero() || event.OccurredAt.Before(at)) {
+			at = event.OccurredAt
+		}
+	}
+	return at, !at.IsZero()
+}
+
+// arrive inserts the visit of the arrival into the visits ordered by arrival, after the visits of the same time,
+// so a late arrival takes its place among the visits the way late locations do in the track. The visit before
+// ends with the arrival, and the inserted visit ends with the arrival of the visit after it, if any.
+// Arrivals without time are appended.
+func (r *MetricsReport) arrive(at time.Time, arrival UnitReachedWarehouse) {
+	visit := WarehouseVisit{UnitReachedWarehouse: arrival, ArrivedAt: at}
+	i := len(r.WarehouseVisits)
+	if !at.IsZero() {
+		for i > 0 && r.WarehouseVisits[i-1].ArrivedAt.After(at) {
+			i--
+		}
+	}
+
+	if i == len(r.WarehouseVisits) {
+		// the last arrival is the one of the last visit
+		r.UnitReachedWarehouse = arrival
+		r.depart(at)
+	} else {
+		visit.DepartedAt = r.WarehouseVisits[i].ArrivedAt
+		// the visit before has ended by the late arrival
+		if i > 0 && (r.WarehouseVisits[i-1].DepartedAt.IsZero() || r.WarehouseVisits[i-1].DepartedAt.After(at)) {
+			r.WarehouseVisits[i-1].DepartedAt = at
+		}
+	}
+	r.WarehouseVisits = slices.Insert(r.WarehouseVisits, i, visit)
+}
+
+// depart ends the visit the unit is staying at, if any, as it was seen elsewhere at.
+// Late events that occurred before the arrival do not end the visit.
+func (r *MetricsReport) depart(at time.Time) {
+	if n := len(r.WarehouseVisits); n > 0 && r.WarehouseVisits[n-1].DepartedAt.IsZero() && !at.Before(r.WarehouseVisits[n-1].ArrivedAt) {
+		r.WarehouseVisits[n-1].DepartedAt = at
+	}
+}
+
+// Project builds report of the unit by replaying its events in order
+func Project(id int64, events []CargoUnitEvent) MetricsReport {
+	report := MetricsReport{ID: id}
+	for _, event := range events {
+		report.Apply(event)
+	}
+	return report
+}
+
+// CargoUnitEventFilter selects events, zero value fields match every event
+type CargoUnitEventFilter struct {
+	CargoUnitIds []int64 `json:"cargo_unit_ids"`
+	// WarehouseId matches only events of units reaching the warehouse
+	WarehouseId int64 `json:"warehouse_id"`
+}
+
+// Match reports whether event satisfies every filter condition
+func (f CargoUnitEventFilter) Match(e CargoUnitEvent) bool {
+	if len(f.CargoUnitIds) > 0 && !slices.Contains(f.CargoUnitIds, e.CargoUnitId) {
+		return false
+	}
+	if f.WarehouseId != 0 && (e.Type != CargoUnitEventReachedWarehouse || e.UnitReachedWarehouse.Announcement.WarehouseId != f.WarehouseId) {
+		return false
+	}
+	return true
+}
+
+type SeriesGroupBy int
+
+const (
+	SeriesGroupByNone SeriesGroupBy = iota
+	SeriesGroupByHour
+	SeriesGroupByDay
+	SeriesGroupByWarehouse
+)
+
+// SeriesFilter selects events metrics report series is counted from and groups them into buckets,
+// zero value fields match every event
+type SeriesFilter struct {
+	// From and To limit the series to events occurred in [From, To)
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// WarehouseIds limits the series to arrivals at the warehouses
+	WarehouseIds []int64       `json:"warehouse_ids"`
+	GroupBy      SeriesGroupBy `json:"group_by"`
+}
+
+// Match reports whether event is counted in the series, only arrivals are counted
+// if the series is limited to warehouses or grouped by them
+func (f SeriesFilter) Match(e CargoUnitEvent) bool {
+	if !f.From.IsZero() && e.OccurredAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !e.OccurredAt.Before(f.To) {
+		return false
+	}
+	if (len(f.WarehouseIds) > 0 || f.GroupBy == SeriesGroupByWarehouse) && e.Type != CargoUnitEventReachedWarehouse {
+		return false
+	}
+	if len(f.WarehouseIds) > 0 && !slices.Contains(f.WarehouseIds, e.UnitReachedWarehouse.Announcement.WarehouseId) {
+		return false
+	}
+	return true
+}
+
+// SeriesBucket counts events of an hour, a day or a warehouse
+type SeriesBucket struct {
+	// Start is the start of the hour or the day in UTC, zero if the series is grouped by warehouse
+	Start time.Time `json:"start"`
+	// WarehouseId is zero unless the series is grouped by warehouse
+	WarehouseId int64 `json:"warehouse_id"`
+	Arrivals    int64 `json:"arrivals"`
+	// ActiveUnits counts units with events in the bucket, every unit is counted once
+	ActiveUnits int64 `json:"active_units"`
+}
+
+// Series counts events into the buckets of its filter
+type Series struct {
+	filter   SeriesFilter
+	arrivals map[seriesKey]int64
+	units    map[seriesKey]map[int64]struct{}
+}
+
+type seriesKey struct {
+	start       int64
+	warehouseId int64
+}
+
+func NewSeries(filter SeriesFilter) *Series {
+	return &Series{
+		filter:   filter,
+		arrivals: make(map[seriesKey]int64),
+		units:    make(map[seriesKey]map[int64]struct{}),
+	}
+}
+
+// Add counts event in its bucket, events not matching the filter are not counted, nor are any if the series is not grouped
+func (s *Series) Add(e CargoUnitEvent) {
+	if !s.filter.Match(e) {
+		return
+	}
+
+	var key seriesKey
+	switch s.filter.GroupBy {
+	case SeriesGroupByHour:
+		key.start = e.OccurredAt.Truncate(time.Hour).UnixNano()
+	case SeriesGroupByDay:
+		key.start = e.OccurredAt.Truncate(24 * time.Hour).UnixNano()
+	case SeriesGroupByWarehouse:
+		key.warehouseId = e.UnitReachedWarehouse.Announcement.WarehouseId
+	default:
+		return
+	}
+
+	if e.Type == CargoUnitEventReachedWarehouse {
+		s.arrivals[key]++
+	}
+	if s.units[key] == nil {
+		s.units[key] = make(map[int64]struct{})
+	}
+	s.units[key][e.CargoUnitId] = struct{}{}
+}
+
+// Buckets returns buckets with events ordered by start or warehouse
+func (s *Series) Buckets() []SeriesBucket {
+	buckets := make([]SeriesBucket, 0, len(s.units))
+	for key, units := range s.units {
+		bucket := SeriesBucket{
+			WarehouseId: key.warehouseId,
+			Arrivals:    s.arrivals[key],
+			ActiveUnits: int64(len(units)),
+		}
+		if s.filter.GroupBy != SeriesGroupByWarehouse {
+			bucket.Start = time.Unix(0, key.start).UTC()
+		}
+		buckets = append(buckets, bucket)
+	}
+	slices.SortFunc(buckets, func(a, b SeriesBucket) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.WarehouseId, b.WarehouseId)
+	})
+	return buckets
+}
+
+// WarehouseVisitsFilter selects warehouse visits, zero value fields match every visit
+type WarehouseVisitsFilter struct {
+	WarehouseId int64 `json:"warehouse_id"`
+	// NotDepartedBefore matches visits the unit departed at or after the time and the ones it is staying at
+	NotDepartedBefore time.Time `json:"not_departed_before"`
+}
+
+// Match reports whether visit satisfies every filter condition
+func (f WarehouseVisitsFilter) Match(v WarehouseVisit) bool {
+	if f.WarehouseId != 0 && v.UnitReachedWarehouse.Announcement.WarehouseId != f.WarehouseId {
+		return false
+	}
+	if !f.NotDepartedBefore.IsZero() && !v.DepartedAt.IsZero() && v.DepartedAt.Before(f.NotDepartedBefore) {
+		return false
+	}
+	return true
+}
+
+// WarehouseStats describes units passing through the warehouse in a time window
+type WarehouseStats struct {
+	WarehouseId int64 `json:"warehouse_id"`
+	// DwellP50, DwellP90 and DwellP99 are percentiles of the time units stayed at the warehouse, of the visits ended in the window
+	DwellP50 time.Duration `json:"dwell_p50"`
+	DwellP90 time.Duration `json:"dwell_p90"`
+	DwellP99 time.Duration `json:"dwell_p99"`
+	// Arrivals and Departures count visits started and ended in the window
+	Arrivals   int64 `json:"arrivals"`
+	Departures int64 `json:"departures"`
+	// Occupancy is a number of units staying at the warehouse at the end of the window
+	Occupancy int64 `json:"occupancy"`
 }
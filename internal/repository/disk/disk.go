@@ -0,0 +1,574 @@
+package disk
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+)
+
+const (
+	defaultFsyncInterval = time.Second
+	defaultSnapshotEvery = 10000
+)
+
+// errBroken is returned by every write after the write-ahead log could not be written or synced,
+// the state of the log on disk is unknown until the repository is reopened
+var errBroken = errors.New("write-ahead log is broken, reopen the repository")
+
+var errClosed = fmt.Errorf("%w: repository is closed", repository.ErrUnavailable)
+
+// FsyncPolicy defines when written records are flushed to the disk
+type FsyncPolicy int
+
+const (
+	// FsyncAlways syncs every record before the write returns, no acknowledged write is lost
+	FsyncAlways FsyncPolicy = iota
+	// FsyncInterval syncs records in background every Options.FsyncInterval,
+	// writes acknowledged during the last interval may be lost on power failure
+	FsyncInterval
+	// FsyncNever leaves flushing to the operating system
+	FsyncNever
+)
+
+// ParseFsyncPolicy parses policy names "always", "interval" and "never".
+func ParseFsyncPolicy(s string) (FsyncPolicy, error) {
+	switch strings.ToLower(s) {
+	case "always":
+		return FsyncAlways, nil
+	case "interval":
+		return FsyncInterval, nil
+	case "never":
+		return FsyncNever, nil
+	}
+	return 0, fmt.Errorf("unknown fsync policy %q", s)
+}
+
+// Options configures Repository, zero values are replaced by defaults
+type Options struct {
+	// Dir keeps write-ahead log segments and snapshots, it is created if missing
+	Dir           string
+	Fsync         FsyncPolicy
+	FsyncInterval time.Duration
+	// SnapshotEvery is a number of written records after which a snapshot is taken
+	// and the log segments it covers are removed
+	SnapshotEvery int
+}
+
+// Repository keeps reports in memory and makes every write durable by appending it
+// to the write-ahead log before it becomes visible. The log is periodically compacted
+// into a snapshot. Open recovers the state from the last snapshot and the log written after it.
+type Repository struct {
+	log  *slog.Logger
+	opts Options
+
+	mu      sync.RWMutex
+	reports map[int64]model.MetricsReport
+	// events keeps the log of every unit, logs are only appended to
+	events map[int64][]model.CargoUnitEvent
+	wal    *segment
+	// records is a number of records written since the last snapshot
+	records int
+	// dirty is set when records were written since the last sync
+	dirty bool
+	// err fails every write once the repository is broken or closed
+	err error
+
+	snapshotCh chan struct{}
+	done       chan struct{}
+	wg         sync.WaitGroup
+	closeOnce  sync.Once
+}
+
+// Open recovers repository from opts.Dir and starts its background syncing and snapshotting.
+func Open(log *slog.Logger, opts Options) (*Repository, error) {
+	const opLabel = "disk.Open"
+
+	if opts.FsyncInterval <= 0 {
+		opts.FsyncInterval = defaultFsyncInterval
+	}
+	if opts.SnapshotEvery <= 0 {
+		opts.SnapshotEvery = defaultSnapshotEvery
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	r := &Repository{
+		log:        log.With(slog.String("dir", opts.Dir)),
+		opts:       opts,
+		reports:    make(map[int64]model.MetricsReport),
+		events:     make(map[int64][]model.CargoUnitEvent),
+		snapshotCh: make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+
+	lastSeq, err := r.recover()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	// records are never appended to recovered segments, their tail might be torn
+	r.wal, err = createSegment(opts.Dir, lastSeq+1)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	r.log.Info("disk repository opened", slog.Int("reports", len(r.reports)), slog.Int("replayed_records", r.records))
+
+	if r.records >= r.opts.SnapshotEvery {
+		r.snapshotCh <- struct{}{}
+	}
+
+	r.wg.Add(1)
+	go r.run()
+
+	return r, nil
+}
+
+// recover loads the last snapshot, replays the log written after it and returns the last segment sequence number.
+func (r *Repository) recover() (uint64, error) {
+	snapshots, err := listSnapshots(r.opts.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastSeq uint64
+	if len(snapshots) > 0 {
+		lastSeq = snapshots[len(snapshots)-1]
+		snap, err := readSnapshot(r.opts.Dir, lastSeq)
+		if err != nil {
+			return 0, err
+		}
+		for _, report := range snap.Reports {
+			r.reports[report.ID] = report
+		}
+		for _, event := range snap.Events {
+			r.events[event.CargoUnitId] = append(r.events[event.CargoUnitId], event)
+		}
+	}
+
+	segments, err := listSegments(r.opts.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, seq := range segments {
+		if seq <= lastSeq {
+			continue
+		}
+
+		path := filepath.Join(r.opts.Dir, segmentName(seq))
+		offset, err := readSegment(path, func(rec record) error {
+			r.apply(rec)
+			r.records++
+			return nil
+		})
+		if errors.Is(err, errTornRecord) {
+			// only the segment being written at the crash may end with a torn record
+			if i != len(segments)-1 {
+				return 0, fmt.Errorf("segment %s is corrupted at offset %d", path, offset)
+			}
+			r.log.Warn("truncating torn record at the end of wal segment", slog.String("segment", path), slog.Int64("offset", offset))
+			if err := os.Truncate(path, offset); err != nil {
+				return 0, err
+			}
+		} else if err != nil {
+			return 0, err
+		}
+
+		lastSeq = seq
+	}
+
+	return lastSeq, nil
+}
+
+// GetAll returns all report data.
+func (r *Repository) GetAll(_ context.Context) ([]model.MetricsReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reports := make([]model.MetricsReport, 0, len(r.reports))
+	for _, report := range r.reports {
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// List returns up to limit reports matching filter with id greater than afterID, ordered by id.
+func (r *Repository) List(_ context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error) {
+	r.mu.RLock()
+	var reports []model.MetricsReport
+	for _, report := range r.reports {
+		if report.ID > afterID && filter.Match(report) {
+			reports = append(reports, report)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID < reports[j].ID
+	})
+	if len(reports) > limit {
+		reports = reports[:limit]
+	}
+
+	return reports, nil
+}
+
+// GetByID returns report data by id.
+func (r *Repository) GetByID(_ context.Context, id int64) (model.MetricsReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if report, exist := r.reports[id]; exist {
+		return report, nil
+	}
+
+	return model.MetricsReport{}, repository.ErrNotFound
+}
+
+// AppendEvents atomically appends events returned by decide to the log of the unit and applies them to its report.
+// decide is given the head of the current report, or an empty report with the id if there is none. Nothing is saved
+// if decide returns an error or no events.
+func (r *Repository) AppendEvents(_ context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error) {
+	const opLabel = "disk.Repository.AppendEvents"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := model.MetricsReport{ID: id}
+	if stored, exist := r.reports[id]; exist {
+		report = stored
+	}
+
+	events, err := decide(report.Head())
+	if err != nil {
+		return model.MetricsReport{}, nil, err
+	}
+	if len(events) == 0 {
+		return report, nil, nil
+	}
+
+	events = slices.Clone(events)
+	for i := range events {
+		events[i].CargoUnitId = id
+		events[i].Sequence = report.Version + int64(i) + 1
+	}
+
+	if err := r.write(record{Op: recordAppend, Report: model.MetricsReport{ID: id}, Events: events}); err != nil {
+		return model.MetricsReport{}, nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return r.reports[id], events, nil
+}
+
+// ListEvents returns up to limit events of the unit with sequence greater than afterSequence, ordered by sequence.
+func (r *Repository) ListEvents(_ context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, exist := r.reports[id]; !exist {
+		return nil, repository.ErrNotFound
+	}
+
+	events := r.events[id]
+	i, _ := slices.BinarySearchFunc(events, afterSequence+1, func(event model.CargoUnitEvent, sequence int64) int {
+		return cmp.Compare(event.Sequence, sequence)
+	})
+	events = events[i:]
+	if len(events) > limit {
+		events = events[:limit]
+	}
+
+	return slices.Clone(events), nil
+}
+
+// ListWarehouseVisits returns warehouse visits matching filter, ordered by unit id and arrival.
+func (r *Repository) ListWarehouseVisits(_ context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error) {
+	r.mu.RLock()
+	var visits []model.WarehouseVisit
+	for _, report := range r.reports {
+		for _, visit := range report.WarehouseVisits {
+			if filter.Match(visit) {
+				visits = append(visits, visit)
+			}
+		}
+	}
+	r.mu.RUnlock()
+
+	slices.SortStableFunc(visits, func(a, b model.WarehouseVisit) int {
+		return cmp.Compare(a.UnitReachedWarehouse.Announcement.CargoUnitId, b.UnitReachedWarehouse.Announcement.CargoUnitId)
+	})
+
+	return visits, nil
+}
+
+// Series counts events of every unit matching filter into its buckets.
+func (r *Repository) Series(_ context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	series := model.NewSeries(filter)
+	for _, events := range r.events {
+		for _, event := range events {
+			series.Add(event)
+		}
+	}
+
+	return series.Buckets(), nil
+}
+
+// Delete deletes report data along with the events of the unit.
+func (r *Repository) Delete(_ context.Context, id int64) error {
+	const opLabel = "disk.Repository.Delete"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exist := r.reports[id]; !exist {
+		return repository.ErrNotFound
+	}
+
+	if err := r.write(record{Op: recordDelete, Report: model.MetricsReport{ID: id}}); err != nil {
+		return fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return nil
+}
+
+// Close stops background work, takes a final snapshot and closes the log.
+func (r *Repository) Close() error {
+	const opLabel = "disk.Repository.Close"
+
+	var err error
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.wg.Wait()
+
+		// with the final snapshot the next Open has nothing to replay
+		snapErr := r.snapshot()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.err = errClosed
+		if closeErr := r.wal.close(); closeErr != nil || snapErr != nil {
+			err = fmt.Errorf("%s: %w", opLabel, errors.Join(snapErr, closeErr))
+		}
+	})
+
+	return err
+}
+
+// write appends rec to the log according to the fsync policy and applies it to the reports, r.mu must be held.
+func (r *Repository) write(rec record) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if err := r.wal.append(rec); err != nil {
+		if errors.Is(err, errBroken) {
+			r.err = errBroken
+		}
+		return err
+	}
+
+	switch r.opts.Fsync {
+	case FsyncAlways:
+		if err := r.wal.sync(); err != nil {
+			r.err = errBroken
+			return fmt.Errorf("%w: %w", errBroken, err)
+		}
+	case FsyncInterval:
+		r.dirty = true
+	}
+
+	r.apply(rec)
+
+	r.records++
+	if r.records >= r.opts.SnapshotEvery {
+		select {
+		case r.snapshotCh <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) apply(rec record) {
+	switch rec.Op {
+	case recordPut:
+		r.reports[rec.Report.ID] = rec.Report
+	case recordDelete:
+		delete(r.reports, rec.Report.ID)
+		delete(r.events, rec.Report.ID)
+	case recordAppend:
+		report := model.MetricsReport{ID: rec.Report.ID}
+		if stored, exist := r.reports[rec.Report.ID]; exist {
+			// applied events must not change the slices shared with readers of the stored report
+			report = stored.Clone()
+		}
+		for _, event := range rec.Events {
+			report.Apply(event)
+		}
+		r.reports[rec.Report.ID] = report
+		r.events[rec.Report.ID] = append(r.events[rec.Report.ID], rec.Events...)
+	}
+}
+
+// run syncs the log according to the fsync policy and takes snapshots until the repository is closed.
+func (r *Repository) run() {
+	defer r.wg.Done()
+
+	var tick <-chan time.Time
+	if r.opts.Fsync == FsyncInterval {
+		ticker := time.NewTicker(r.opts.FsyncInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-tick:
+			r.sync()
+		case <-r.snapshotCh:
+			if err := r.snapshot(); err != nil {
+				r.log.Error("failed to take snapshot", logging.Err(err))
+			}
+		}
+	}
+}
+
+// sync flushes records written since the last sync.
+func (r *Repository) sync() {
+	r.mu.Lock()
+	if !r.dirty || r.err != nil {
+		r.mu.Unlock()
+		return
+	}
+	r.dirty = false
+	wal := r.wal
+	r.mu.Unlock()
+
+	// the segment is rotated only by run, so it is not closed while syncing
+	if err := wal.sync(); err != nil {
+		r.log.Error("failed to sync wal segment", logging.Err(err))
+
+		r.mu.Lock()
+		r.err = errBroken
+		r.mu.Unlock()
+	}
+}
+
+// snapshot seals the current segment, saves the reports it covers and removes the files the snapshot replaces.
+func (r *Repository) snapshot() error {
+	r.mu.Lock()
+
+	if r.err != nil {
+		r.mu.Unlock()
+		return r.err
+	}
+
+	sealed := r.wal
+	if err := sealed.close(); err != nil {
+		r.err = errBroken
+		r.mu.Unlock()
+		return fmt.Errorf("%w: %w", errBroken, err)
+	}
+	next, err := createSegment(r.opts.Dir, sealed.seq+1)
+	if err != nil {
+		r.err = errBroken
+		r.mu.Unlock()
+		return fmt.Errorf("%w: %w", errBroken, err)
+	}
+	r.wal = next
+	r.dirty = false
+	r.records = 0
+
+	snap := snapshot{
+		Segment: sealed.seq,
+		Reports: make([]model.MetricsReport, 0, len(r.reports)),
+	}
+	for _, report := range r.reports {
+		snap.Reports = append(snap.Reports, report)
+	}
+	// logs are only appended to, so their events up to the current length are not changed after unlocking
+	logs := make([][]model.CargoUnitEvent, 0, len(r.events))
+	for _, events := range r.events {
+		logs = append(logs, events)
+	}
+
+	r.mu.Unlock()
+
+	sort.Slice(snap.Reports, func(i, j int) bool {
+		return snap.Reports[i].ID < snap.Reports[j].ID
+	})
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i][0].CargoUnitId < logs[j][0].CargoUnitId
+	})
+	for _, events := range logs {
+		snap.Events = append(snap.Events, events...)
+	}
+
+	// until the snapshot is written the sealed segments are kept and replayed on recovery
+	if err := writeSnapshot(r.opts.Dir, snap); err != nil {
+		return err
+	}
+
+	r.log.Debug("snapshot taken", slog.Uint64("segment", snap.Segment), slog.Int("reports", len(snap.Reports)))
+
+	return r.compact(snap.Segment)
+}
+
+// compact removes segments covered by the snapshot of seq, older snapshots and leftovers of failed snapshots.
+func (r *Repository) compact(seq uint64) error {
+	var errs []error
+
+	segments, err := listSegments(r.opts.Dir)
+	if err != nil {
+		return err
+	}
+	for _, s := range segments {
+		if s <= seq {
+			errs = append(errs, os.Remove(filepath.Join(r.opts.Dir, segmentName(s))))
+		}
+	}
+
+	snapshots, err := listSnapshots(r.opts.Dir)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		if s < seq {
+			errs = append(errs, os.Remove(filepath.Join(r.opts.Dir, snapshotName(s))))
+		}
+	}
+
+	leftovers, err := filepath.Glob(filepath.Join(r.opts.Dir, snapshotPrefix+"*.tmp-*"))
+	if err != nil {
+		return err
+	}
+	for _, leftover := range leftovers {
+		errs = append(errs, os.Remove(leftover))
+	}
+
+	return errors.Join(errs...)
+}
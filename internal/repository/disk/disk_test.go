@@ -0,0 +1,353 @@
+package disk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repository {
+		r, err := Open(slog.New(slog.NewTextHandler(io.Discard, nil)), Options{Dir: t.TempDir(), Fsync: FsyncNever, SnapshotEvery: 50})
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
+
+// open opens the repository in dir, snapshots are taken only by the test
+func open(t *testing.T, dir string) *Repository {
+	t.Helper()
+
+	r, err := Open(slog.New(slog.NewTextHandler(io.Discard, nil)), Options{Dir: dir, Fsync: FsyncNever, SnapshotEvery: 1 << 20})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+// crash copies the files of the open repository in dir, as they are left by a process that died without Close
+func crash(t *testing.T, dir string) string {
+	t.Helper()
+
+	copied := t.TempDir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(copied, entry.Name()), data, 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	return copied
+}
+
+// lastSegment returns the path of the segment records are written to
+func lastSegment(t *testing.T, dir string) string {
+	t.Helper()
+
+	segments, err := listSegments(dir)
+	if err != nil || len(segments) == 0 {
+		t.Fatalf("listSegments() = %v, %v, want segments", segments, err)
+	}
+	return filepath.Join(dir, segmentName(segments[len(segments)-1]))
+}
+
+// move appends a move of the unit seen at the minute and fails the test on error
+func move(t *testing.T, r *Repository, id int64, minutes int) {
+	t.Helper()
+
+	event := model.CargoUnitEvent{
+		Type:       model.CargoUnitEventMoved,
+		Location:   model.Location{Latitude: float64(minutes), Longitude: float64(id)},
+		OccurredAt: time.Date(2024, time.May, 1, 12, minutes, 0, 0, time.UTC),
+	}
+	_, _, err := r.AppendEvents(context.Background(), id, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		return []model.CargoUnitEvent{event}, nil
+	})
+	if err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+}
+
+// dump encodes the reports and the event logs of every unit, ordered by id
+func dump(t *testing.T, r *Repository) string {
+	t.Helper()
+
+	ctx := context.Background()
+	reports, err := r.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID < reports[j].ID
+	})
+
+	var events []model.CargoUnitEvent
+	for _, report := range reports {
+		log, err := r.ListEvents(ctx, report.ID, 0, 1<<20)
+		if err != nil {
+			t.Fatalf("ListEvents() error = %v", err)
+		}
+		events = append(events, log...)
+	}
+
+	data, err := json.Marshal(snapshot{Reports: reports, Events: events})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	return string(data)
+}
+
+// write moves units 1 and 2 and deletes unit 3, so every record type is replayed
+func write(t *testing.T, r *Repository) {
+	t.Helper()
+
+	for minutes := 1; minutes <= 3; minutes++ {
+		move(t, r, 1, minutes)
+		move(t, r, 2, minutes)
+		move(t, r, 3, minutes)
+	}
+	if err := r.Delete(context.Background(), 3); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+}
+
+func TestReopen(t *testing.T) {
+	dir := t.TempDir()
+	r := open(t, dir)
+	write(t, r)
+	want := dump(t, r)
+
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, _, err := r.AppendEvents(context.Background(), 1, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		return []model.CargoUnitEvent{{Type: model.CargoUnitEventMoved}}, nil
+	}); !errors.Is(err, repository.ErrUnavailable) {
+		t.Fatalf("AppendEvents() after Close error = %v, want %v", err, repository.ErrUnavailable)
+	}
+
+	// Close leaves only the final snapshot and an empty segment to recover from
+	reopened := open(t, dir)
+	if got := dump(t, reopened); got != want {
+		t.Fatalf("got state %s after reopening, want %s", got, want)
+	}
+	if reopened.records != 0 {
+		t.Fatalf("got %d replayed records, want 0", reopened.records)
+	}
+
+	// versions continue from the recovered ones
+	move(t, reopened, 1, 4)
+	report, err := reopened.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if report.Version != 4 || len(report.MoveUnit.Location) != 4 {
+		t.Fatalf("got version %d and %d locations, want 4 and 4", report.Version, len(report.MoveUnit.Location))
+	}
+}
+
+func TestRecoverAfterCrash(t *testing.T) {
+	dir := t.TempDir()
+	r := open(t, dir)
+	write(t, r)
+	want := dump(t, r)
+
+	recovered := open(t, crash(t, dir))
+	if got := dump(t, recovered); got != want {
+		t.Fatalf("got state %s after crash, want %s", got, want)
+	}
+	// three moves of three units and a delete
+	if recovered.records != 10 {
+		t.Fatalf("got %d replayed records, want 10", recovered.records)
+	}
+}
+
+func TestRecoverTruncatesTornRecord(t *testing.T) {
+	tests := []struct {
+		name string
+		// tear damages the last segment of size bytes
+		tear func(t *testing.T, path string, size int64)
+		// lost is set if the last move is lost along with the torn record
+		lost bool
+	}{
+		{
+			name: "partial header",
+			tear: func(t *testing.T, path string, size int64) {
+				file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+				if err != nil {
+					t.Fatalf("OpenFile() error = %v", err)
+				}
+				defer file.Close()
+				if _, err := file.Write([]byte{1, 2, 3}); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "partial payload",
+			tear: func(t *testing.T, path string, size int64) {
+				if err := os.Truncate(path, size-5); err != nil {
+					t.Fatalf("Truncate() error = %v", err)
+				}
+			},
+			lost: true,
+		},
+		{
+			name: "checksum mismatch",
+			tear: func(t *testing.T, path string, size int64) {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("ReadFile() error = %v", err)
+				}
+				data[len(data)-2] ^= 0xff
+				if err := os.WriteFile(path, data, 0o644); err != nil {
+					t.Fatalf("WriteFile() error = %v", err)
+				}
+			},
+			lost: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			r := open(t, dir)
+			move(t, r, 1, 1)
+			move(t, r, 1, 2)
+			info, err := os.Stat(lastSegment(t, dir))
+			if err != nil {
+				t.Fatalf("Stat() error = %v", err)
+			}
+			// recovery cuts the segment right after the last valid record
+			wantSize, wantMoves := info.Size(), 2
+			move(t, r, 1, 3)
+
+			crashed := crash(t, dir)
+			path := lastSegment(t, crashed)
+			if info, err = os.Stat(path); err != nil {
+				t.Fatalf("Stat() error = %v", err)
+			}
+			if !tt.lost {
+				wantSize, wantMoves = info.Size(), 3
+			}
+			tt.tear(t, path, info.Size())
+
+			recovered := open(t, crashed)
+			report, err := recovered.GetByID(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("GetByID() error = %v", err)
+			}
+			if len(report.MoveUnit.Location) != wantMoves || report.Version != int64(wantMoves) {
+				t.Fatalf("got version %d and %d locations, want %d and %d", report.Version, len(report.MoveUnit.Location), wantMoves, wantMoves)
+			}
+
+			info, err = os.Stat(path)
+			if err != nil {
+				t.Fatalf("Stat() error = %v", err)
+			}
+			if info.Size() != wantSize {
+				t.Fatalf("got segment of %d bytes after recovery, want %d", info.Size(), wantSize)
+			}
+
+			// records written after recovery are kept by the next recovery
+			move(t, recovered, 1, 4)
+			want := dump(t, recovered)
+			if got := dump(t, open(t, crash(t, crashed))); got != want {
+				t.Fatalf("got state %s after second crash, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRecoverRejectsCorruptedSealedSegment(t *testing.T) {
+	dir := t.TempDir()
+	r := open(t, dir)
+	move(t, r, 1, 1)
+
+	crashed := crash(t, dir)
+	path := lastSegment(t, crashed)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if err := os.Truncate(path, info.Size()-1); err != nil {
+		t.Fatalf("Truncate() error = %v", err)
+	}
+	// the torn segment is followed by another one, so it was not the one being written at the crash
+	segments, err := listSegments(crashed)
+	if err != nil {
+		t.Fatalf("listSegments() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(crashed, segmentName(segments[len(segments)-1]+1)), nil, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := Open(slog.New(slog.NewTextHandler(io.Discard, nil)), Options{Dir: crashed}); err == nil {
+		t.Fatalf("Open() error = nil, want corrupted segment error")
+	}
+}
+
+func TestRecoverSnapshotThenLog(t *testing.T) {
+	dir := t.TempDir()
+	r := open(t, dir)
+	for minutes := 1; minutes <= 3; minutes++ {
+		move(t, r, 1, minutes)
+		move(t, r, 2, minutes)
+	}
+	if err := r.snapshot(); err != nil {
+		t.Fatalf("snapshot() error = %v", err)
+	}
+
+	snapshots, err := listSnapshots(dir)
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("listSnapshots() = %v, %v, want a snapshot", snapshots, err)
+	}
+	// the segments covered by the snapshot are removed
+	segments, err := listSegments(dir)
+	if err != nil || len(segments) != 1 || segments[0] <= snapshots[0] {
+		t.Fatalf("listSegments() = %v, %v, want a segment after snapshot of %d", segments, err, snapshots[0])
+	}
+
+	// the log written after the snapshot extends a unit of the snapshot, adds one and deletes one
+	move(t, r, 1, 4)
+	move(t, r, 3, 4)
+	if err := r.Delete(context.Background(), 2); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	want := dump(t, r)
+
+	recovered := open(t, crash(t, dir))
+	if got := dump(t, recovered); got != want {
+		t.Fatalf("got state %s after crash, want %s", got, want)
+	}
+	if recovered.records != 3 {
+		t.Fatalf("got %d replayed records, want 3 written after the snapshot", recovered.records)
+	}
+	report, err := recovered.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if report.Version != 4 || len(report.MoveUnit.Location) != 4 {
+		t.Fatalf("got version %d and %d locations, want 4 and 4", report.Version, len(report.MoveUnit.Location))
+	}
+}
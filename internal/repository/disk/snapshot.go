@@ -0,0 +1,106 @@
+package disk
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+)
+
+const (
+	snapshotPrefix = "snapshot-"
+	snapshotSuffix = ".json"
+)
+
+// snapshot is the state of the reports after applying every segment up to and including Segment
+type snapshot struct {
+	Segment uint64                `json:"segment"`
+	Reports []model.MetricsReport `json:"reports"`
+	// Events are the logs of every unit, so reports can be replayed after compaction
+	Events []model.CargoUnitEvent `json:"events"`
+}
+
+func snapshotName(seq uint64) string {
+	return fmt.Sprintf("%s%020d%s", snapshotPrefix, seq, snapshotSuffix)
+}
+
+// writeSnapshot atomically writes the snapshot file, it is never seen partially written.
+func writeSnapshot(dir string, snap snapshot) error {
+	path := filepath.Join(dir, snapshotName(snap.Segment))
+
+	tmp, err := os.CreateTemp(dir, snapshotName(snap.Segment)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	if err := json.NewEncoder(w).Encode(snap); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// readSnapshot reads the snapshot covering segments up to seq.
+func readSnapshot(dir string, seq uint64) (snapshot, error) {
+	file, err := os.Open(filepath.Join(dir, snapshotName(seq)))
+	if err != nil {
+		return snapshot{}, err
+	}
+	defer file.Close()
+
+	var snap snapshot
+	if err := json.NewDecoder(bufio.NewReader(file)).Decode(&snap); err != nil {
+		return snapshot{}, fmt.Errorf("snapshot %s: %w", file.Name(), err)
+	}
+
+	return snap, nil
+}
+
+// listSnapshots returns segment sequence numbers covered by the snapshots in dir in ascending order.
+func listSnapshots(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotPrefix) || !strings.HasSuffix(name, snapshotSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, snapshotPrefix), snapshotSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs, nil
+}
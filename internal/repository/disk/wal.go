@@ -0,0 +1,198 @@
+package disk
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+)
+
+const (
+	segmentPrefix = "wal-"
+	segmentSuffix = ".log"
+
+	// recordHeaderSize is a size of record payload length followed by its checksum
+	recordHeaderSize = 8
+	// maxRecordSize protects recovery from allocating memory for a garbage length
+	maxRecordSize = 64 << 20
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errTornRecord is returned when the log ends with a partially written or corrupted record
+var errTornRecord = errors.New("torn wal record")
+
+type recordOp string
+
+const (
+	// recordPut is no longer written, reports are changed by appending events only.
+	// Put records of the logs written before are replayed as they were.
+	recordPut    recordOp = "put"
+	recordDelete recordOp = "delete"
+	recordAppend recordOp = "append"
+)
+
+// record is a single change of the reports, put records carry the whole report,
+// append records carry events applied to the report with Report.ID
+type record struct {
+	Op     recordOp               `json:"op"`
+	Report model.MetricsReport    `json:"report"`
+	Events []model.CargoUnitEvent `json:"events,omitempty"`
+}
+
+// segment is a write-ahead log file, records are appended to the last segment only
+type segment struct {
+	seq  uint64
+	file *os.File
+	// size is an offset right after the last completely written record
+	size int64
+}
+
+func segmentName(seq uint64) string {
+	return fmt.Sprintf("%s%020d%s", segmentPrefix, seq, segmentSuffix)
+}
+
+// createSegment creates a new empty segment file in dir.
+func createSegment(dir string, seq uint64) (*segment, error) {
+	file, err := os.OpenFile(filepath.Join(dir, segmentName(seq)), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syncDir(dir); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &segment{seq: seq, file: file}, nil
+}
+
+// append writes rec with a single write, a failed write is cut off the segment so it never precedes valid records.
+func (s *segment) append(rec record) error {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, recordHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+	copy(buf[recordHeaderSize:], payload)
+
+	if _, err := s.file.Write(buf); err != nil {
+		if truncErr := s.file.Truncate(s.size); truncErr != nil {
+			return errors.Join(err, fmt.Errorf("%w: %w", errBroken, truncErr))
+		}
+		return err
+	}
+	s.size += int64(len(buf))
+
+	return nil
+}
+
+func (s *segment) sync() error {
+	return s.file.Sync()
+}
+
+func (s *segment) close() error {
+	if err := s.file.Sync(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+// listSegments returns sequence numbers of the segments in dir in ascending order.
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, segmentPrefix) || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, segmentPrefix), segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs, nil
+}
+
+// readSegment calls apply for every record of the segment and returns the offset after the last valid record.
+// errTornRecord is returned along with the offset if the segment does not end with a complete record.
+func readSegment(path string, apply func(record) error) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	header := make([]byte, recordHeaderSize)
+	var offset int64
+
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if errors.Is(err, io.EOF) {
+				return offset, nil
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return offset, errTornRecord
+			}
+			return offset, err
+		}
+
+		size := binary.LittleEndian.Uint32(header[0:4])
+		if size > maxRecordSize {
+			return offset, errTornRecord
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return offset, errTornRecord
+			}
+			return offset, err
+		}
+		if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+			return offset, errTornRecord
+		}
+
+		var rec record
+		if err := json.Unmarshal(payload, &rec); err != nil {
+			return offset, errTornRecord
+		}
+		if err := apply(rec); err != nil {
+			return offset, err
+		}
+
+		offset += int64(recordHeaderSize) + int64(size)
+	}
+}
+
+// syncDir makes creation, removal and renaming of the files in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -6,6 +6,18 @@ import "errors"
 // record is not found
 var ErrNotFound = errors.New("metrics report not found")
 
-// ErrAlreadyExists is returned when a requested metrics report
-// record already exists
+// ErrAlreadyExists is returned when a metrics report record
+// required not to exist yet is already there
 var ErrAlreadyExists = errors.New("metrics report already exists")
+
+// ErrConflict is returned when a metrics report record
+// was changed since the version being updated was read
+var ErrConflict = errors.New("metrics report version conflict")
+
+// ErrAborted is returned when the storage aborted the operation
+// as it ran into a concurrent one, the operation may succeed if retried
+var ErrAborted = errors.New("metrics report operation aborted by concurrent operation")
+
+// ErrUnavailable is returned when the storage can not be
+// reached, the operation may succeed if retried
+var ErrUnavailable = errors.New("metrics report storage unavailable")
@@ -1,16 +1,26 @@
 package memory
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"sort"
 	"sync"
 
 	"github.com/ivanbulyk/logistics_engine_api/internal/model"
 	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
 )
 
-// Repository defines a memory allocation service repository
+// shardsNumber is a number of locks writes to the reports are spread over
+const shardsNumber = 256
+
+// Repository defines a memory allocation service repository.
+// Reads are lock free, writes of the same report are serialized by its shard lock.
 type Repository struct {
 	DB sync.Map
+	// events keeps the log of every unit, logs are only appended to
+	events sync.Map
+	shards [shardsNumber]sync.Mutex
 }
 
 // New creates a new memory repository
@@ -30,6 +40,28 @@ func (r *Repository) GetAll(_ context.Context) ([]model.MetricsReport, error) {
 	return reports, nil
 }
 
+// List returns up to limit reports matching filter with id greater than afterID, ordered by id.
+func (r *Repository) List(_ context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error) {
+	var reports []model.MetricsReport
+
+	r.DB.Range(func(key, value interface{}) bool {
+		report := value.(model.MetricsReport)
+		if report.ID > afterID && filter.Match(report) {
+			reports = append(reports, report)
+		}
+		return true
+	})
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID < reports[j].ID
+	})
+	if len(reports) > limit {
+		reports = reports[:limit]
+	}
+
+	return reports, nil
+}
+
 // GetByID returns report data by id.
 func (r *Repository) GetByID(_ context.Context, id int64) (model.MetricsReport, error) {
 
@@ -40,38 +72,119 @@ func (r *Repository) GetByID(_ context.Context, id int64) (model.MetricsReport,
 	return model.MetricsReport{}, repository.ErrNotFound
 }
 
-// Create creates report .
-func (r *Repository) Create(_ context.Context, report model.MetricsReport) (model.MetricsReport, error) {
+// AppendEvents atomically appends events returned by decide to the log of the unit and applies them to its report.
+// decide is given the head of the current report, or an empty report with the id if there is none. Nothing is saved
+// if decide returns an error or no events.
+func (r *Repository) AppendEvents(_ context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error) {
+	unlock := r.lock(id)
+	defer unlock()
+
+	report := model.MetricsReport{ID: id}
+	if stored, exist := r.DB.Load(id); exist {
+		report = stored.(model.MetricsReport)
+	}
+
+	events, err := decide(report.Head())
+	if err != nil {
+		return model.MetricsReport{}, nil, err
+	}
+	if len(events) == 0 {
+		return report, nil, nil
+	}
 
-	if _, exist := r.DB.Load(report.ID); exist {
-		return model.MetricsReport{}, repository.ErrAlreadyExists
+	// applied events must not change the slices shared with readers of the stored report
+	report = report.Clone()
+	events = slices.Clone(events)
+	for i := range events {
+		events[i].CargoUnitId = id
+		events[i].Sequence = report.Version + 1
+		report.Apply(events[i])
 	}
 
-	r.DB.Store(report.ID, report)
+	var log []model.CargoUnitEvent
+	if stored, exist := r.events.Load(id); exist {
+		log = stored.([]model.CargoUnitEvent)
+	}
+	r.events.Store(id, append(log, events...))
+	r.DB.Store(id, report)
 
-	return report, nil
+	return report, events, nil
 }
 
-// Update updates report data.
-func (r *Repository) Update(_ context.Context, report model.MetricsReport) error {
+// ListEvents returns up to limit events of the unit with sequence greater than afterSequence, ordered by sequence.
+func (r *Repository) ListEvents(_ context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error) {
+	if _, exist := r.DB.Load(id); !exist {
+		return nil, repository.ErrNotFound
+	}
+
+	var log []model.CargoUnitEvent
+	if stored, exist := r.events.Load(id); exist {
+		log = stored.([]model.CargoUnitEvent)
+	}
 
-	if _, exist := r.DB.Load(report.ID); !exist {
-		return repository.ErrNotFound
+	i, _ := slices.BinarySearchFunc(log, afterSequence+1, func(event model.CargoUnitEvent, sequence int64) int {
+		return cmp.Compare(event.Sequence, sequence)
+	})
+	log = log[i:]
+	if len(log) > limit {
+		log = log[:limit]
 	}
 
-	r.DB.Store(report.ID, report)
+	return slices.Clone(log), nil
+}
 
-	return nil
+// ListWarehouseVisits returns warehouse visits matching filter, ordered by unit id and arrival.
+func (r *Repository) ListWarehouseVisits(_ context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error) {
+	var visits []model.WarehouseVisit
+
+	r.DB.Range(func(key, value interface{}) bool {
+		for _, visit := range value.(model.MetricsReport).WarehouseVisits {
+			if filter.Match(visit) {
+				visits = append(visits, visit)
+			}
+		}
+		return true
+	})
+
+	slices.SortStableFunc(visits, func(a, b model.WarehouseVisit) int {
+		return cmp.Compare(a.UnitReachedWarehouse.Announcement.CargoUnitId, b.UnitReachedWarehouse.Announcement.CargoUnitId)
+	})
+
+	return visits, nil
+}
+
+// Series counts events of every unit matching filter into its buckets.
+func (r *Repository) Series(_ context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error) {
+	series := model.NewSeries(filter)
+
+	r.events.Range(func(key, value interface{}) bool {
+		for _, event := range value.([]model.CargoUnitEvent) {
+			series.Add(event)
+		}
+		return true
+	})
+
+	return series.Buckets(), nil
 }
 
-// Delete deletes report data.
+// Delete deletes report data along with the events of the unit.
 func (r *Repository) Delete(_ context.Context, id int64) error {
+	unlock := r.lock(id)
+	defer unlock()
 
 	if _, exist := r.DB.Load(id); !exist {
 		return repository.ErrNotFound
 	}
 
 	r.DB.Delete(id)
+	r.events.Delete(id)
 
 	return nil
 }
+
+// lock locks shard of the report with id and returns its unlock function.
+func (r *Repository) lock(id int64) func() {
+	mu := &r.shards[uint64(id)%shardsNumber]
+	mu.Lock()
+	return mu.Unlock
+}
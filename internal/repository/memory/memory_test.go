@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repository {
+		return New()
+	})
+}
+
+// TestAppendEventsConcurrentNoLostEvents is meant to be run with -race.
+func TestAppendEventsConcurrentNoLostEvents(t *testing.T) {
+	const (
+		writers = 50
+		updates = 200
+		id      = int64(1)
+	)
+
+	ctx := context.Background()
+	r := New()
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for u := 0; u < updates; u++ {
+				_, _, err := r.AppendEvents(ctx, id, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+					return []model.CargoUnitEvent{{
+						Type:     model.CargoUnitEventMoved,
+						Location: model.Location{Latitude: float64(w), Longitude: float64(u)},
+					}}, nil
+				})
+				if err != nil {
+					t.Errorf("AppendEvents() error = %v", err)
+					return
+				}
+			}
+		}(w)
+	}
+
+	// readers race with the writers over the same report
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := 0; u < updates; u++ {
+				if report, err := r.GetByID(ctx, id); err == nil {
+					for _, l := range report.MoveUnit.Location {
+						_ = l.Latitude
+					}
+				}
+				_, _ = r.GetAll(ctx)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	report, err := r.GetByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got, want := len(report.MoveUnit.Location), writers*updates; got != want || report.Version != int64(want) {
+		t.Fatalf("got %d locations and version %d, want %d", got, report.Version, want)
+	}
+
+	seen := make(map[model.Location]bool, writers*updates)
+	for _, l := range report.MoveUnit.Location {
+		if seen[l] {
+			t.Fatalf("location %+v saved twice", l)
+		}
+		seen[l] = true
+	}
+}
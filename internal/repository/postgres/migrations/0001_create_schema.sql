@@ -0,0 +1,28 @@
+-- cargo_units keeps a row per tracked unit
+CREATE TABLE cargo_units (
+    id           BIGINT PRIMARY KEY,
+    version      BIGINT NOT NULL,
+    last_seen_at TIMESTAMPTZ
+);
+
+CREATE INDEX cargo_units_last_seen_at ON cargo_units (last_seen_at);
+
+-- locations keeps the track of the unit, seq orders locations the way they were reported
+CREATE TABLE locations (
+    cargo_unit_id BIGINT  NOT NULL REFERENCES cargo_units (id) ON DELETE CASCADE,
+    seq           INTEGER NOT NULL,
+    latitude      BIGINT  NOT NULL,
+    longitude     BIGINT  NOT NULL,
+    PRIMARY KEY (cargo_unit_id, seq)
+);
+
+-- warehouse_arrivals keeps a row per unit that has reached warehouse
+CREATE TABLE warehouse_arrivals (
+    cargo_unit_id BIGINT PRIMARY KEY REFERENCES cargo_units (id) ON DELETE CASCADE,
+    warehouse_id  BIGINT NOT NULL,
+    message       TEXT   NOT NULL,
+    latitude      BIGINT NOT NULL,
+    longitude     BIGINT NOT NULL
+);
+
+CREATE INDEX warehouse_arrivals_warehouse_id ON warehouse_arrivals (warehouse_id);
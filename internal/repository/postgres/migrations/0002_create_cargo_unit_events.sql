@@ -0,0 +1,13 @@
+-- cargo_unit_events keeps the immutable log of every unit, reports are projections of the log.
+-- type is 1 for unit moved and 2 for unit reached warehouse events
+CREATE TABLE cargo_unit_events (
+    cargo_unit_id BIGINT      NOT NULL REFERENCES cargo_units (id) ON DELETE CASCADE,
+    sequence      BIGINT      NOT NULL,
+    type          SMALLINT    NOT NULL,
+    occurred_at   TIMESTAMPTZ NOT NULL,
+    latitude      BIGINT      NOT NULL,
+    longitude     BIGINT      NOT NULL,
+    warehouse_id  BIGINT,
+    message       TEXT,
+    PRIMARY KEY (cargo_unit_id, sequence)
+);
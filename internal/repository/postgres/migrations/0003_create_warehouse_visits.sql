@@ -0,0 +1,25 @@
+-- warehouse_visits keeps every warehouse the unit has reached, seq orders visits by arrival.
+-- departed_at is NULL while the unit stays at the warehouse
+CREATE TABLE warehouse_visits (
+    cargo_unit_id BIGINT  NOT NULL REFERENCES cargo_units (id) ON DELETE CASCADE,
+    seq           INTEGER NOT NULL,
+    warehouse_id  BIGINT  NOT NULL,
+    message       TEXT    NOT NULL,
+    latitude      BIGINT  NOT NULL,
+    longitude     BIGINT  NOT NULL,
+    arrived_at    TIMESTAMPTZ,
+    departed_at   TIMESTAMPTZ,
+    PRIMARY KEY (cargo_unit_id, seq)
+);
+
+CREATE INDEX warehouse_visits_warehouse_id ON warehouse_visits (warehouse_id);
+
+-- visits are replayed from the logged events, the unit departs with the event following its arrival
+INSERT INTO warehouse_visits (cargo_unit_id, seq, warehouse_id, message, latitude, longitude, arrived_at, departed_at)
+SELECT cargo_unit_id, ROW_NUMBER() OVER (PARTITION BY cargo_unit_id ORDER BY sequence) - 1,
+       warehouse_id, message, latitude, longitude, occurred_at, departed_at
+FROM (
+    SELECT *, LEAD(occurred_at) OVER (PARTITION BY cargo_unit_id ORDER BY sequence) AS departed_at
+    FROM cargo_unit_events
+) AS e
+WHERE type = 2;
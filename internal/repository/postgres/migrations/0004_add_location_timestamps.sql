@@ -0,0 +1,36 @@
+-- recorded_at is the time reported by the device and received_at is the time the server received the location,
+-- both are NULL if unknown. A location of an event is received along with the event.
+ALTER TABLE locations ADD COLUMN recorded_at TIMESTAMPTZ;
+ALTER TABLE locations ADD COLUMN received_at TIMESTAMPTZ;
+
+ALTER TABLE warehouse_arrivals ADD COLUMN recorded_at TIMESTAMPTZ;
+ALTER TABLE warehouse_arrivals ADD COLUMN received_at TIMESTAMPTZ;
+
+ALTER TABLE warehouse_visits ADD COLUMN recorded_at TIMESTAMPTZ;
+ALTER TABLE warehouse_visits ADD COLUMN received_at TIMESTAMPTZ;
+
+ALTER TABLE cargo_unit_events ADD COLUMN recorded_at TIMESTAMPTZ;
+ALTER TABLE cargo_unit_events ADD COLUMN received_at TIMESTAMPTZ;
+
+-- events logged so far occurred at the time they were received
+UPDATE cargo_unit_events SET received_at = occurred_at;
+
+-- so were the locations, visits and arrivals saved along with them. The track ends with the locations of
+-- the logged moves, earlier locations were saved before the log was introduced and stay unknown.
+UPDATE locations SET received_at = e.occurred_at
+FROM (
+    SELECT cargo_unit_id, occurred_at, ROW_NUMBER() OVER (PARTITION BY cargo_unit_id ORDER BY sequence DESC) AS from_end
+    FROM cargo_unit_events
+    WHERE type = 1
+) AS e
+WHERE e.cargo_unit_id = locations.cargo_unit_id
+    AND e.from_end = (SELECT COUNT(*) FROM locations l WHERE l.cargo_unit_id = locations.cargo_unit_id) - locations.seq;
+
+UPDATE warehouse_visits SET received_at = arrived_at;
+
+UPDATE warehouse_arrivals SET received_at = (
+    SELECT v.arrived_at FROM warehouse_visits v
+    WHERE v.cargo_unit_id = warehouse_arrivals.cargo_unit_id
+    ORDER BY v.seq DESC
+    LIMIT 1
+);
@@ -0,0 +1,34 @@
+-- latitude and longitude are WGS84 degrees, altitude, accuracy, heading and speed are NULL
+-- unless reported by the device
+
+ALTER TABLE locations
+    ALTER COLUMN latitude TYPE DOUBLE PRECISION,
+    ALTER COLUMN longitude TYPE DOUBLE PRECISION,
+    ADD COLUMN altitude DOUBLE PRECISION,
+    ADD COLUMN accuracy DOUBLE PRECISION,
+    ADD COLUMN heading DOUBLE PRECISION,
+    ADD COLUMN speed DOUBLE PRECISION;
+
+ALTER TABLE warehouse_arrivals
+    ALTER COLUMN latitude TYPE DOUBLE PRECISION,
+    ALTER COLUMN longitude TYPE DOUBLE PRECISION,
+    ADD COLUMN altitude DOUBLE PRECISION,
+    ADD COLUMN accuracy DOUBLE PRECISION,
+    ADD COLUMN heading DOUBLE PRECISION,
+    ADD COLUMN speed DOUBLE PRECISION;
+
+ALTER TABLE warehouse_visits
+    ALTER COLUMN latitude TYPE DOUBLE PRECISION,
+    ALTER COLUMN longitude TYPE DOUBLE PRECISION,
+    ADD COLUMN altitude DOUBLE PRECISION,
+    ADD COLUMN accuracy DOUBLE PRECISION,
+    ADD COLUMN heading DOUBLE PRECISION,
+    ADD COLUMN speed DOUBLE PRECISION;
+
+ALTER TABLE cargo_unit_events
+    ALTER COLUMN latitude TYPE DOUBLE PRECISION,
+    ALTER COLUMN longitude TYPE DOUBLE PRECISION,
+    ADD COLUMN altitude DOUBLE PRECISION,
+    ADD COLUMN accuracy DOUBLE PRECISION,
+    ADD COLUMN heading DOUBLE PRECISION,
+    ADD COLUMN speed DOUBLE PRECISION;
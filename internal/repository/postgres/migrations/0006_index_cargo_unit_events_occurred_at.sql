@@ -0,0 +1,2 @@
+-- metrics report series are counted from the events of every unit occurred in the requested time window
+CREATE INDEX cargo_unit_events_occurred_at ON cargo_unit_events (occurred_at);
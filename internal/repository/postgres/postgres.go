@@ -0,0 +1,1105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolOptions configures connection pool, zero values keep pgxpool defaults
+type PoolOptions struct {
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+}
+
+// errNoEvents rolls back the row of a missing unit locked by AppendEvents when there is nothing to append
+var errNoEvents = errors.New("no events")
+
+// Repository keeps reports in PostgreSQL, normalized into cargo_units, locations and warehouse_arrivals tables,
+// along with the log of events the reports are projected from in cargo_unit_events table.
+// Writes of the same report are serialized by its cargo_units row lock, so replicas may share the database.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Open connects to the database at dsn and migrates its schema to the latest version.
+func Open(ctx context.Context, dsn string, opts PoolOptions) (*Repository, error) {
+	const opLabel = "postgres.Open"
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+	if opts.MaxConns > 0 {
+		cfg.MaxConns = opts.MaxConns
+	}
+	if opts.MinConns > 0 {
+		cfg.MinConns = opts.MinConns
+	}
+	if opts.MaxConnLifetime > 0 {
+		cfg.MaxConnLifetime = opts.MaxConnLifetime
+	}
+	if opts.MaxConnIdleTime > 0 {
+		cfg.MaxConnIdleTime = opts.MaxConnIdleTime
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	if err := migrate(ctx, pool); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("%s: %w", opLabel, storageError(err))
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+// Close closes every connection of the pool.
+func (r *Repository) Close() error {
+	r.pool.Close()
+	return nil
+}
+
+// GetAll returns all report data.
+func (r *Repository) GetAll(ctx context.Context) ([]model.MetricsReport, error) {
+	const opLabel = "postgres.Repository.GetAll"
+
+	var reports []model.MetricsReport
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		var err error
+		reports, err = getReports(ctx, tx, "", nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return reports, nil
+}
+
+// List returns up to limit reports matching filter with id greater than afterID, ordered by id.
+func (r *Repository) List(ctx context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error) {
+	const opLabel = "postgres.Repository.List"
+
+	args := []any{afterID}
+	conditions := []string{"u.id > $1"}
+	if filter.WarehouseId != 0 {
+		args = append(args, filter.WarehouseId)
+		conditions = append(conditions, "a.warehouse_id = $"+strconv.Itoa(len(args)))
+	}
+	switch filter.Status {
+	case model.CargoUnitStatusInTransit:
+		conditions = append(conditions, "a.cargo_unit_id IS NULL")
+	case model.CargoUnitStatusArrived:
+		conditions = append(conditions, "a.cargo_unit_id IS NOT NULL")
+	}
+	if !filter.LastSeenAfter.IsZero() {
+		args = append(args, filter.LastSeenAfter)
+		conditions = append(conditions, "u.last_seen_at > $"+strconv.Itoa(len(args)))
+	}
+	args = append(args, limit)
+
+	where := strings.Join(conditions, " AND ") + " ORDER BY u.id LIMIT $" + strconv.Itoa(len(args))
+
+	var reports []model.MetricsReport
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		var err error
+		reports, err = getReports(ctx, tx, where, args)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return reports, nil
+}
+
+// GetByID returns report data by id.
+func (r *Repository) GetByID(ctx context.Context, id int64) (model.MetricsReport, error) {
+	const opLabel = "postgres.Repository.GetByID"
+
+	var report model.MetricsReport
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		var err error
+		report, err = getReport(ctx, tx, id)
+		return err
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return model.MetricsReport{}, err
+	}
+	if err != nil {
+		return model.MetricsReport{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return report, nil
+}
+
+// AppendEvents atomically appends events returned by decide to the log of the unit and applies them to its report.
+// decide is given the head of the current report, or an empty report with the id if there is none. Nothing is saved
+// if decide returns an error or no events. The head is loaded with the row of the unit locked and returned,
+// the events add their rows to the track and the visits, so a ping costs the same on a unit of any track length.
+func (r *Repository) AppendEvents(ctx context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error) {
+	const opLabel = "postgres.Repository.AppendEvents"
+
+	var report model.MetricsReport
+	var events []model.CargoUnitEvent
+	var decideErr error
+	err := r.write(ctx, func(tx pgx.Tx) error {
+		// a missing unit is created with version 0, so there is a row to lock,
+		// it is never seen by others as the transaction either saves the report or rolls back
+		if _, err := tx.Exec(ctx, "INSERT INTO cargo_units (id, version) VALUES ($1, 0) ON CONFLICT (id) DO NOTHING", id); err != nil {
+			return err
+		}
+		stored, visitSeq, err := getHead(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		report = stored
+		events, decideErr = decide(report)
+		if decideErr != nil {
+			return decideErr
+		}
+		if len(events) == 0 {
+			return errNoEvents
+		}
+
+		// late arrivals are inserted among the visits the head does not hold
+		if at, late := stored.LateArrival(events); late {
+			stored.WarehouseVisits, visitSeq, err = getVisitsFrom(ctx, tx, id, at)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the locked head tells which visit rows the events have changed, the arrival and the visits started
+		// by the events are written in a statement each
+		report = stored.Clone()
+		events = slices.Clone(events)
+		for i := range events {
+			events[i].CargoUnitId = id
+			events[i].Sequence = report.Version + 1
+			report.Apply(events[i])
+		}
+
+		if err := saveHead(ctx, tx, stored, visitSeq, report); err != nil {
+			return err
+		}
+		for _, event := range events {
+			if event.Type != model.CargoUnitEventMoved {
+				continue
+			}
+			if err := insertLocation(ctx, tx, id, event.Location); err != nil {
+				return err
+			}
+		}
+		if err := saveEvents(ctx, tx, events); err != nil {
+			return err
+		}
+		report = report.Head()
+		return nil
+	})
+	if decideErr != nil {
+		return model.MetricsReport{}, nil, decideErr
+	}
+	if errors.Is(err, errNoEvents) {
+		return report, nil, nil
+	}
+	if err != nil {
+		return model.MetricsReport{}, nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return report, events, nil
+}
+
+// ListEvents returns up to limit events of the unit with sequence greater than afterSequence, ordered by sequence.
+func (r *Repository) ListEvents(ctx context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error) {
+	const opLabel = "postgres.Repository.ListEvents"
+
+	var events []model.CargoUnitEvent
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		var exist bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM cargo_units WHERE id = $1)", id).Scan(&exist); err != nil {
+			return err
+		}
+		if !exist {
+			return repository.ErrNotFound
+		}
+
+		rows, err := tx.Query(ctx, `
+			SELECT sequence, type, occurred_at, warehouse_id, message,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at
+			FROM cargo_unit_events
+			WHERE cargo_unit_id = $1 AND sequence > $2
+			ORDER BY sequence
+			LIMIT $3`,
+			id, afterSequence, limit,
+		)
+		if err != nil {
+			return err
+		}
+
+		events, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.CargoUnitEvent, error) {
+			var (
+				event       = model.CargoUnitEvent{CargoUnitId: id}
+				eventType   int16
+				warehouseID *int64
+				message     *string
+				columns     locationRow
+			)
+			if err := row.Scan(append([]any{&event.Sequence, &eventType, &event.OccurredAt, &warehouseID, &message}, columns.dest()...)...); err != nil {
+				return model.CargoUnitEvent{}, err
+			}
+			event.Type = model.CargoUnitEventType(eventType)
+			// the location is received along with the event
+			location := columns.location()
+			event.ReceivedAt = location.ReceivedAt
+			switch event.Type {
+			case model.CargoUnitEventMoved:
+				event.Location = location
+			case model.CargoUnitEventReachedWarehouse:
+				event.UnitReachedWarehouse = model.UnitReachedWarehouse{
+					Location: location,
+					Announcement: model.WarehouseAnnouncement{
+						CargoUnitId: id,
+						WarehouseId: *warehouseID,
+						Message:     *message,
+					},
+				}
+			case model.CargoUnitEventDepartedWarehouse:
+				event.UnitDepartedWarehouse = model.UnitDepartedWarehouse{
+					Location: location,
+					Announcement: model.WarehouseAnnouncement{
+						CargoUnitId: id,
+						WarehouseId: *warehouseID,
+						Message:     *message,
+					},
+				}
+			}
+			return event, nil
+		})
+		return err
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return events, nil
+}
+
+// Delete deletes report data along with the events of the unit.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const opLabel = "postgres.Repository.Delete"
+
+	tag, err := r.pool.Exec(ctx, "DELETE FROM cargo_units WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", opLabel, storageError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// Report aggregates metrics report in the database.
+func (r *Repository) Report(ctx context.Context) (model.Report, error) {
+	const opLabel = "postgres.Repository.Report"
+
+	report := model.Report{
+		WarehousesReceivedSuppliesList:                []int64{},
+		DeliveryUnitsReachedDestination:               []int64{},
+		InTransitUnits:                                []int64{},
+		DeliveryUnitsEachWarehouseReceivedTotalNumber: []model.DeliveryUnitsWarehouseReceivedTotalNumber{},
+	}
+
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, "SELECT COUNT(*) FROM cargo_units").Scan(&report.DeliveryUnitsTotalNumber); err != nil {
+			return err
+		}
+
+		// every visit is counted, units saved without visits are counted under their last arrival
+		// and units in transit are not counted, the same way the service aggregates reports itself
+		rows, err := tx.Query(ctx, `
+			SELECT warehouse_id, COUNT(*)
+			FROM (
+				SELECT warehouse_id FROM warehouse_visits
+				UNION ALL
+				SELECT a.warehouse_id
+				FROM warehouse_arrivals a
+				WHERE NOT EXISTS (SELECT 1 FROM warehouse_visits v WHERE v.cargo_unit_id = a.cargo_unit_id)
+			) AS visited (warehouse_id)
+			GROUP BY 1
+			ORDER BY 1`)
+		if err != nil {
+			return err
+		}
+		totals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DeliveryUnitsWarehouseReceivedTotalNumber, error) {
+			var total model.DeliveryUnitsWarehouseReceivedTotalNumber
+			err := row.Scan(&total.WarehouseId, &total.DeliveryUnitsNumber)
+			return total, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, total := range totals {
+			report.WarehousesReceivedSuppliesList = append(report.WarehousesReceivedSuppliesList, total.WarehouseId)
+			report.DeliveryUnitsEachWarehouseReceivedTotalNumber = append(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, total)
+		}
+
+		// units that have reached a warehouse have their last arrival saved, the others are in transit
+		rows, err = tx.Query(ctx, `
+			SELECT u.id, a.cargo_unit_id IS NOT NULL
+			FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id
+			ORDER BY u.id`)
+		if err != nil {
+			return err
+		}
+		var (
+			id      int64
+			reached bool
+		)
+		_, err = pgx.ForEachRow(rows, []any{&id, &reached}, func() error {
+			if reached {
+				report.DeliveryUnitsReachedDestination = append(report.DeliveryUnitsReachedDestination, id)
+			} else {
+				report.InTransitUnits = append(report.InTransitUnits, id)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		stats := &report.TravelStats
+		return tx.QueryRow(ctx, travelStatsQuery, earthRadiusMeters).Scan(
+			&stats.DistanceMeters, &stats.StraightLineMeters, &stats.TimedDistanceMeters, &stats.TimedSeconds, &stats.MaxSpeed,
+		)
+	})
+	if err != nil {
+		return model.Report{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return report, nil
+}
+
+// Size counts units in the database and their events.
+func (r *Repository) Size(ctx context.Context) (model.RepositorySize, error) {
+	const opLabel = "postgres.Repository.Size"
+
+	var size model.RepositorySize
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		// units in transit have no warehouse arrival saved
+		return tx.QueryRow(ctx, `
+			SELECT
+				(SELECT COUNT(*) FROM cargo_units),
+				(SELECT COUNT(*) FROM cargo_units u WHERE NOT EXISTS (SELECT 1 FROM warehouse_arrivals a WHERE a.cargo_unit_id = u.id)),
+				(SELECT COUNT(*) FROM cargo_unit_events)`).Scan(&size.CargoUnits, &size.InTransitUnits, &size.Events)
+	})
+	if err != nil {
+		return model.RepositorySize{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return size, nil
+}
+
+// ListWarehouseVisits returns warehouse visits matching filter, ordered by unit id and arrival.
+func (r *Repository) ListWarehouseVisits(ctx context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error) {
+	const opLabel = "postgres.Repository.ListWarehouseVisits"
+
+	conditions := []string{"TRUE"}
+	var args []any
+	if filter.WarehouseId != 0 {
+		args = append(args, filter.WarehouseId)
+		conditions = append(conditions, "warehouse_id = $"+strconv.Itoa(len(args)))
+	}
+	if !filter.NotDepartedBefore.IsZero() {
+		args = append(args, filter.NotDepartedBefore)
+		conditions = append(conditions, "(departed_at IS NULL OR departed_at >= $"+strconv.Itoa(len(args))+")")
+	}
+
+	var visits []model.WarehouseVisit
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+warehouseVisitColumns+`
+			FROM warehouse_visits
+			WHERE `+strings.Join(conditions, " AND ")+`
+			ORDER BY cargo_unit_id, seq`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			visit, err := scanWarehouseVisit(rows)
+			if err != nil {
+				return err
+			}
+			visits = append(visits, visit)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return visits, nil
+}
+
+// Series counts events of every unit matching filter into its buckets in the database.
+func (r *Repository) Series(ctx context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error) {
+	const opLabel = "postgres.Repository.Series"
+
+	args := []any{int16(model.CargoUnitEventReachedWarehouse)}
+	// hours and days start in UTC whatever the time zone of the session is
+	var key string
+	switch filter.GroupBy {
+	case model.SeriesGroupByHour:
+		key = "date_trunc('hour', occurred_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC', 0::BIGINT"
+	case model.SeriesGroupByDay:
+		key = "date_trunc('day', occurred_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC', 0::BIGINT"
+	case model.SeriesGroupByWarehouse:
+		key = "NULL::TIMESTAMPTZ, warehouse_id"
+	default:
+		return []model.SeriesBucket{}, nil
+	}
+
+	conditions := []string{"TRUE"}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, "occurred_at >= $"+strconv.Itoa(len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, "occurred_at < $"+strconv.Itoa(len(args)))
+	}
+	if len(filter.WarehouseIds) > 0 || filter.GroupBy == model.SeriesGroupByWarehouse {
+		conditions = append(conditions, "type = $1")
+	}
+	if len(filter.WarehouseIds) > 0 {
+		args = append(args, filter.WarehouseIds)
+		conditions = append(conditions, "warehouse_id = ANY($"+strconv.Itoa(len(args))+")")
+	}
+
+	var buckets []model.SeriesBucket
+	err := r.read(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+key+`, COUNT(*) FILTER (WHERE type = $1), COUNT(DISTINCT cargo_unit_id)
+			FROM cargo_unit_events
+			WHERE `+strings.Join(conditions, " AND ")+`
+			GROUP BY 1, 2
+			ORDER BY 1, 2`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+		buckets, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.SeriesBucket, error) {
+			var (
+				bucket model.SeriesBucket
+				start  *time.Time
+			)
+			err := row.Scan(&start, &bucket.WarehouseId, &bucket.Arrivals, &bucket.ActiveUnits)
+			if start != nil {
+				bucket.Start = start.UTC()
+			}
+			return bucket, err
+		})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return buckets, nil
+}
+
+// earthRadiusMeters is the mean radius of the WGS84 ellipsoid the service computes travel stats of a unit with
+const earthRadiusMeters = 6371008.8
+
+// travelStatsQuery adds up travel stats of the tracks of every unit the way the service computes them for a unit:
+// haversine distances between consecutive locations, speeds over segments between locations of increasing time only.
+const travelStatsQuery = `
+	WITH points AS (
+		SELECT cargo_unit_id, seq, radians(latitude) AS lat, radians(longitude) AS lng,
+			COALESCE(recorded_at, received_at) AS at
+		FROM locations
+	),
+	segments AS (
+		SELECT 2 * $1::double precision * asin(LEAST(1, sqrt(
+				power(sin((b.lat - a.lat) / 2), 2) + cos(a.lat) * cos(b.lat) * power(sin((b.lng - a.lng) / 2), 2)
+			))) AS meters,
+			EXTRACT(EPOCH FROM b.at - a.at)::double precision AS seconds
+		FROM points a JOIN points b ON b.cargo_unit_id = a.cargo_unit_id AND b.seq = a.seq + 1
+	),
+	ends AS (
+		SELECT 2 * $1::double precision * asin(LEAST(1, sqrt(
+				power(sin((b.lat - a.lat) / 2), 2) + cos(a.lat) * cos(b.lat) * power(sin((b.lng - a.lng) / 2), 2)
+			))) AS meters
+		FROM (SELECT cargo_unit_id, MAX(seq) AS seq FROM locations GROUP BY cargo_unit_id) AS last
+		JOIN points a ON a.cargo_unit_id = last.cargo_unit_id AND a.seq = 0
+		JOIN points b ON b.cargo_unit_id = last.cargo_unit_id AND b.seq = last.seq
+	)
+	SELECT
+		(SELECT COALESCE(SUM(meters), 0) FROM segments),
+		(SELECT COALESCE(SUM(meters), 0) FROM ends),
+		(SELECT COALESCE(SUM(meters), 0) FROM segments WHERE seconds > 0),
+		(SELECT COALESCE(SUM(seconds), 0) FROM segments WHERE seconds > 0),
+		(SELECT COALESCE(MAX(meters / seconds), 0) FROM segments WHERE seconds > 0)`
+
+// read runs fn in a read only transaction seeing a single snapshot of the database.
+func (r *Repository) read(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	err := pgx.BeginTxFunc(ctx, r.pool, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, fn)
+	return storageError(err)
+}
+
+// write runs fn in a transaction and commits it if fn succeeds.
+func (r *Repository) write(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	err := pgx.BeginFunc(ctx, r.pool, fn)
+	return storageError(err)
+}
+
+// storageError marks errors the operation may succeed after being retried with: transactions aborted
+// by concurrent ones and the database that can not be reached.
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		// serialization_failure and deadlock_detected
+		case pgErr.Code == "40001" || pgErr.Code == "40P01":
+			return fmt.Errorf("%w: %w", repository.ErrAborted, err)
+		// cannot_connect_now and connection_exception class
+		case pgErr.Code == "57P03" || strings.HasPrefix(pgErr.Code, "08"):
+			return fmt.Errorf("%w: %w", repository.ErrUnavailable, err)
+		}
+		return err
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || pgconn.SafeToRetry(err) {
+		return fmt.Errorf("%w: %w", repository.ErrUnavailable, err)
+	}
+
+	return err
+}
+
+// getReport returns report with id or repository.ErrNotFound.
+func getReport(ctx context.Context, q querier, id int64) (model.MetricsReport, error) {
+	reports, err := getReports(ctx, q, "u.id = $1", []any{id})
+	if err != nil {
+		return model.MetricsReport{}, err
+	}
+	if len(reports) == 0 {
+		return model.MetricsReport{}, repository.ErrNotFound
+	}
+	return reports[0], nil
+}
+
+// getHead returns the head of the report with id, locking its row until the transaction ends,
+// along with the seq of its last visit, 0 if it has none, or repository.ErrNotFound.
+func getHead(ctx context.Context, q querier, id int64) (model.MetricsReport, int32, error) {
+	report, err := scanReport(q.QueryRow(ctx, `
+		SELECT `+reportColumns+`
+		FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id
+		WHERE u.id = $1
+		FOR UPDATE OF u`,
+		id,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.MetricsReport{}, 0, repository.ErrNotFound
+	}
+	if err != nil {
+		return model.MetricsReport{}, 0, err
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT `+warehouseVisitColumns+`, seq
+		FROM warehouse_visits
+		WHERE cargo_unit_id = $1
+		ORDER BY seq DESC
+		LIMIT 1`,
+		id,
+	)
+	if err != nil {
+		return model.MetricsReport{}, 0, err
+	}
+	defer rows.Close()
+
+	var seq int32
+	for rows.Next() {
+		visit, err := scanWarehouseVisit(rows, &seq)
+		if err != nil {
+			return model.MetricsReport{}, 0, err
+		}
+		report.WarehouseVisits = []model.WarehouseVisit{visit}
+	}
+
+	return report, seq, rows.Err()
+}
+
+// getVisitsFrom returns the visits of the unit from the last one that began by at, or from the first one,
+// along with the seq of the first returned visit.
+func getVisitsFrom(ctx context.Context, q querier, id int64, at time.Time) ([]model.WarehouseVisit, int32, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+warehouseVisitColumns+`, seq
+		FROM warehouse_visits
+		WHERE cargo_unit_id = $1 AND seq >= COALESCE((
+			SELECT MAX(seq)
+			FROM warehouse_visits
+			WHERE cargo_unit_id = $1 AND (arrived_at IS NULL OR arrived_at <= $2)
+		), 0)
+		ORDER BY seq`,
+		id, at,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var visits []model.WarehouseVisit
+	var first int32
+	for rows.Next() {
+		var seq int32
+		visit, err := scanWarehouseVisit(rows, &seq)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(visits) == 0 {
+			first = seq
+		}
+		visits = append(visits, visit)
+	}
+
+	return visits, first, rows.Err()
+}
+
+// reportColumns are the columns of cargo_units u left joined with warehouse_arrivals a scanned by scanReport
+const reportColumns = `u.id, u.version, u.last_seen_at, a.warehouse_id, a.message,
+	a.latitude, a.longitude, a.altitude, a.accuracy, a.heading, a.speed, a.recorded_at, a.received_at`
+
+// scanReport scans reportColumns into a report without its track and visits.
+func scanReport(row pgx.Row) (model.MetricsReport, error) {
+	var (
+		report      model.MetricsReport
+		lastSeenAt  *time.Time
+		warehouseID *int64
+		message     *string
+		arrival     locationRow
+	)
+	if err := row.Scan(append([]any{&report.ID, &report.Version, &lastSeenAt, &warehouseID, &message}, arrival.dest()...)...); err != nil {
+		return model.MetricsReport{}, err
+	}
+	if lastSeenAt != nil {
+		report.LastSeenAt = *lastSeenAt
+	}
+	if warehouseID != nil {
+		report.UnitReachedWarehouse = model.UnitReachedWarehouse{
+			Location: arrival.location(),
+			Announcement: model.WarehouseAnnouncement{
+				CargoUnitId: report.ID,
+				WarehouseId: *warehouseID,
+				Message:     *message,
+			},
+		}
+	}
+	return report, nil
+}
+
+// getReports returns reports of the units selected by where clause, optionally followed by ORDER BY or LIMIT,
+// over cargo_units u left joined with warehouse_arrivals a. Every report is returned if where is empty.
+func getReports(ctx context.Context, q querier, where string, args []any) ([]model.MetricsReport, error) {
+	query := `
+		SELECT ` + reportColumns + `
+		FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id`
+	if where != "" {
+		query += " WHERE " + where
+	} else {
+		query += " ORDER BY u.id"
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.MetricsReport, error) {
+		return scanReport(row)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return reports, nil
+	}
+
+	index := make(map[int64]int, len(reports))
+	ids := make([]int64, 0, len(reports))
+	for i, report := range reports {
+		index[report.ID] = i
+		ids = append(ids, report.ID)
+	}
+
+	// locations and visits of the selected units only, every unit is selected if where is empty
+	var unitsWhere string
+	var unitsArgs []any
+	if where != "" {
+		unitsWhere = " WHERE cargo_unit_id = ANY($1)"
+		unitsArgs = append(unitsArgs, ids)
+	}
+
+	locations, err := q.Query(ctx, `
+		SELECT cargo_unit_id, latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at
+		FROM locations`+unitsWhere+`
+		ORDER BY cargo_unit_id, seq`,
+		unitsArgs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer locations.Close()
+
+	for locations.Next() {
+		var (
+			id  int64
+			row locationRow
+		)
+		if err := locations.Scan(append([]any{&id}, row.dest()...)...); err != nil {
+			return nil, err
+		}
+		i, exist := index[id]
+		if !exist {
+			continue
+		}
+		reports[i].MoveUnit.CargoUnitId = id
+		reports[i].MoveUnit.Location = append(reports[i].MoveUnit.Location, row.location())
+	}
+	if err := locations.Err(); err != nil {
+		return nil, err
+	}
+
+	visits, err := q.Query(ctx, `
+		SELECT `+warehouseVisitColumns+`
+		FROM warehouse_visits`+unitsWhere+`
+		ORDER BY cargo_unit_id, seq`,
+		unitsArgs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer visits.Close()
+
+	for visits.Next() {
+		visit, err := scanWarehouseVisit(visits)
+		if err != nil {
+			return nil, err
+		}
+		i, exist := index[visit.UnitReachedWarehouse.Announcement.CargoUnitId]
+		if !exist {
+			continue
+		}
+		reports[i].WarehouseVisits = append(reports[i].WarehouseVisits, visit)
+	}
+
+	return reports, visits.Err()
+}
+
+// saveEvents inserts events into the log.
+func saveEvents(ctx context.Context, q querier, events []model.CargoUnitEvent) error {
+	var (
+		ids          = make([]int64, 0, len(events))
+		sequences    = make([]int64, 0, len(events))
+		types        = make([]int16, 0, len(events))
+		occurredAt   = make([]time.Time, 0, len(events))
+		warehouseIDs = make([]*int64, 0, len(events))
+		messages     = make([]*string, 0, len(events))
+		locations    = newLocationArrays(len(events))
+	)
+	for _, event := range events {
+		location := event.Location
+		var warehouseID *int64
+		var message *string
+		switch event.Type {
+		case model.CargoUnitEventReachedWarehouse:
+			location = event.UnitReachedWarehouse.Location
+			warehouseID = &event.UnitReachedWarehouse.Announcement.WarehouseId
+			message = &event.UnitReachedWarehouse.Announcement.Message
+		case model.CargoUnitEventDepartedWarehouse:
+			location = event.UnitDepartedWarehouse.Location
+			warehouseID = &event.UnitDepartedWarehouse.Announcement.WarehouseId
+			message = &event.UnitDepartedWarehouse.Announcement.Message
+		}
+		ids = append(ids, event.CargoUnitId)
+		sequences = append(sequences, event.Sequence)
+		types = append(types, int16(event.Type))
+		occurredAt = append(occurredAt, event.OccurredAt)
+		warehouseIDs = append(warehouseIDs, warehouseID)
+		messages = append(messages, message)
+		// the location is received along with the event
+		location.ReceivedAt = event.ReceivedAt
+		locations.append(location)
+	}
+
+	_, err := q.Exec(ctx, `
+		INSERT INTO cargo_unit_events (cargo_unit_id, sequence, type, occurred_at, warehouse_id, message,
+			latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+		SELECT * FROM unnest(
+			$1::bigint[], $2::bigint[], $3::smallint[], $4::timestamptz[], $5::bigint[], $6::text[],
+			$7::double precision[], $8::double precision[], $9::double precision[], $10::double precision[],
+			$11::double precision[], $12::double precision[], $13::timestamptz[], $14::timestamptz[]
+		)`,
+		append([]any{ids, sequences, types, occurredAt, warehouseIDs, messages}, locations.args()...)...,
+	)
+	return err
+}
+
+// saveHead writes the report the events were applied to, except for its track. stored is the locked head
+// the events were applied to, with version 0 if the unit was just created, and visitSeq is the seq of its first visit.
+// Events replace the arrival, end the stored visit and start new ones, the new visits are inserted with unnest.
+func saveHead(ctx context.Context, q querier, stored model.MetricsReport, visitSeq int32, report model.MetricsReport) error {
+	if _, err := q.Exec(ctx,
+		"UPDATE cargo_units SET version = $1, last_seen_at = $2 WHERE id = $3",
+		report.Version, nullTime(report.LastSeenAt), report.ID,
+	); err != nil {
+		return err
+	}
+
+	if report.ReachedWarehouse() && !report.UnitReachedWarehouse.Equal(stored.UnitReachedWarehouse) {
+		arrival := report.UnitReachedWarehouse
+		if _, err := q.Exec(ctx, `
+			INSERT INTO warehouse_arrivals (cargo_unit_id, warehouse_id, message,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (cargo_unit_id) DO UPDATE SET
+				warehouse_id = excluded.warehouse_id,
+				message = excluded.message,
+				latitude = excluded.latitude,
+				longitude = excluded.longitude,
+				altitude = excluded.altitude,
+				accuracy = excluded.accuracy,
+				heading = excluded.heading,
+				speed = excluded.speed,
+				recorded_at = excluded.recorded_at,
+				received_at = excluded.received_at`,
+			report.ID, arrival.Announcement.WarehouseId, arrival.Announcement.Message,
+			arrival.Location.Latitude, arrival.Location.Longitude,
+			arrival.Location.Altitude, arrival.Location.Accuracy, arrival.Location.Heading, arrival.Location.Speed,
+			nullTime(arrival.Location.RecordedAt), nullTime(arrival.Location.ReceivedAt),
+		); err != nil {
+			return err
+		}
+	}
+
+	// visits are rewritten from the first one the events have changed, that is the stored visit the events end
+	// unless a late arrival is inserted before it
+	first := 0
+	for first < len(stored.WarehouseVisits) && first < len(report.WarehouseVisits) && report.WarehouseVisits[first].Equal(stored.WarehouseVisits[first]) {
+		first++
+	}
+	if first < len(stored.WarehouseVisits) {
+		if _, err := q.Exec(ctx, "DELETE FROM warehouse_visits WHERE cargo_unit_id = $1 AND seq >= $2", report.ID, visitSeq+int32(first)); err != nil {
+			return err
+		}
+	}
+	added, visitSeq := report.WarehouseVisits[first:], visitSeq+int32(first)
+	if len(added) == 0 {
+		return nil
+	}
+
+	seqs := make([]int32, 0, len(added))
+	warehouseIDs := make([]int64, 0, len(added))
+	messages := make([]string, 0, len(added))
+	arrivedAt := make([]*time.Time, 0, len(added))
+	departedAt := make([]*time.Time, 0, len(added))
+	locations := newLocationArrays(len(added))
+	for i, visit := range added {
+		seqs = append(seqs, visitSeq+int32(i))
+		warehouseIDs = append(warehouseIDs, visit.UnitReachedWarehouse.Announcement.WarehouseId)
+		messages = append(messages, visit.UnitReachedWarehouse.Announcement.Message)
+		arrivedAt = append(arrivedAt, nullTime(visit.ArrivedAt))
+		departedAt = append(departedAt, nullTime(visit.DepartedAt))
+		locations.append(visit.UnitReachedWarehouse.Location)
+	}
+	_, err := q.Exec(ctx, `
+		INSERT INTO warehouse_visits (cargo_unit_id, seq, warehouse_id, message, arrived_at, departed_at,
+			latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+		SELECT $1, t.*
+		FROM unnest(
+			$2::integer[], $3::bigint[], $4::text[], $5::timestamptz[], $6::timestamptz[],
+			$7::double precision[], $8::double precision[], $9::double precision[], $10::double precision[],
+			$11::double precision[], $12::double precision[], $13::timestamptz[], $14::timestamptz[]
+		) AS t (seq, warehouse_id, message, arrived_at, departed_at,
+			latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)`,
+		append([]any{report.ID, seqs, warehouseIDs, messages, arrivedAt, departedAt}, locations.args()...)...,
+	)
+	return err
+}
+
+// insertLocation inserts location into the track of the unit the way model.Apply does, after the last location
+// of the same or earlier time, so the backward scan of the primary key index stops at the last row for locations
+// reported in time. Locations without time are appended.
+func insertLocation(ctx context.Context, q querier, id int64, location model.Location) error {
+	var seq int32
+	err := q.QueryRow(ctx, `
+		SELECT seq + 1
+		FROM locations
+		WHERE cargo_unit_id = $1
+			AND ($2::timestamptz IS NULL OR COALESCE(recorded_at, received_at) IS NULL OR COALESCE(recorded_at, received_at) <= $2)
+		ORDER BY seq DESC
+		LIMIT 1`,
+		id, nullTime(location.Time()),
+	).Scan(&seq)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	// the primary key is not deferrable and is checked for every updated row,
+	// so the later locations are moved through negative seq to keep it unique meanwhile
+	tag, err := q.Exec(ctx, "UPDATE locations SET seq = -seq - 1 WHERE cargo_unit_id = $1 AND seq >= $2", id, seq)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() > 0 {
+		if _, err := q.Exec(ctx, "UPDATE locations SET seq = -seq WHERE cargo_unit_id = $1 AND seq < 0", id); err != nil {
+			return err
+		}
+	}
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO locations (cargo_unit_id, seq, latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		id, seq, location.Latitude, location.Longitude, location.Altitude, location.Accuracy, location.Heading, location.Speed,
+		nullTime(location.RecordedAt), nullTime(location.ReceivedAt),
+	)
+	return err
+}
+
+// nullTime returns nil for zero t, so it is saved as NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// warehouseVisitColumns are the columns of warehouse_visits scanned by scanWarehouseVisit
+const warehouseVisitColumns = `cargo_unit_id, warehouse_id, message, arrived_at, departed_at,
+	latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at`
+
+// scanWarehouseVisit scans warehouseVisitColumns into a visit and the columns following them into dest.
+func scanWarehouseVisit(rows pgx.Rows, dest ...any) (model.WarehouseVisit, error) {
+	var (
+		visit                 model.WarehouseVisit
+		arrivedAt, departedAt *time.Time
+		row                   locationRow
+	)
+	announcement := &visit.UnitReachedWarehouse.Announcement
+	columns := append([]any{&announcement.CargoUnitId, &announcement.WarehouseId, &announcement.Message, &arrivedAt, &departedAt}, row.dest()...)
+	if err := rows.Scan(append(columns, dest...)...); err != nil {
+		return model.WarehouseVisit{}, err
+	}
+	visit.UnitReachedWarehouse.Location = row.location()
+	visit.ArrivedAt = timeOf(arrivedAt)
+	visit.DepartedAt = timeOf(departedAt)
+	return visit, nil
+}
+
+// locationRow scans columns latitude, longitude, altitude, accuracy, heading, speed, recorded_at and received_at
+// of a location, they are NULL in warehouse_arrivals left joined to a unit in transit
+type locationRow struct {
+	latitude, longitude                *float64
+	altitude, accuracy, heading, speed *float64
+	recordedAt, receivedAt             *time.Time
+}
+
+func (l *locationRow) dest() []any {
+	return []any{&l.latitude, &l.longitude, &l.altitude, &l.accuracy, &l.heading, &l.speed, &l.recordedAt, &l.receivedAt}
+}
+
+func (l *locationRow) location() model.Location {
+	location := model.Location{
+		Altitude:   l.altitude,
+		Accuracy:   l.accuracy,
+		Heading:    l.heading,
+		Speed:      l.speed,
+		RecordedAt: timeOf(l.recordedAt),
+		ReceivedAt: timeOf(l.receivedAt),
+	}
+	if l.latitude != nil && l.longitude != nil {
+		location.Latitude, location.Longitude = *l.latitude, *l.longitude
+	}
+	return location
+}
+
+// locationArrays collects the columns scanned by locationRow of several locations to insert them with unnest
+type locationArrays struct {
+	latitudes, longitudes                   []float64
+	altitudes, accuracies, headings, speeds []*float64
+	recordedAt, receivedAt                  []*time.Time
+}
+
+func newLocationArrays(capacity int) *locationArrays {
+	return &locationArrays{
+		latitudes:  make([]float64, 0, capacity),
+		longitudes: make([]float64, 0, capacity),
+		altitudes:  make([]*float64, 0, capacity),
+		accuracies: make([]*float64, 0, capacity),
+		headings:   make([]*float64, 0, capacity),
+		speeds:     make([]*float64, 0, capacity),
+		recordedAt: make([]*time.Time, 0, capacity),
+		receivedAt: make([]*time.Time, 0, capacity),
+	}
+}
+
+func (a *locationArrays) append(location model.Location) {
+	a.latitudes = append(a.latitudes, location.Latitude)
+	a.longitudes = append(a.longitudes, location.Longitude)
+	a.altitudes = append(a.altitudes, location.Altitude)
+	a.accuracies = append(a.accuracies, location.Accuracy)
+	a.headings = append(a.headings, location.Heading)
+	a.speeds = append(a.speeds, location.Speed)
+	a.recordedAt = append(a.recordedAt, nullTime(location.RecordedAt))
+	a.receivedAt = append(a.receivedAt, nullTime(location.ReceivedAt))
+}
+
+// args returns the arrays in the order of the columns scanned by locationRow.
+func (a *locationArrays) args() []any {
+	return []any{a.latitudes, a.longitudes, a.altitudes, a.accuracies, a.headings, a.speeds, a.recordedAt, a.receivedAt}
+}
+
+// timeOf returns zero time for NULL t.
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
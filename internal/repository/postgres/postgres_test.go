@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/repositorytest"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// envTestDSN points integration tests to a local database, e.g. postgres://postgres@localhost:5432/logistics_test.
+// Tests wipe every table of the database.
+const envTestDSN = "POSTGRES_TEST_DSN"
+
+func openTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	dsn := os.Getenv(envTestDSN)
+	if dsn == "" {
+		// CI provides a database, so the integration tests are never skipped there
+		if os.Getenv("CI") != "" {
+			t.Fatalf("%s is not set", envTestDSN)
+		}
+		t.Skipf("%s is not set", envTestDSN)
+	}
+
+	ctx := context.Background()
+	r, err := Open(ctx, dsn, PoolOptions{MaxConns: 20})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { r.Close() })
+
+	if _, err := r.pool.Exec(ctx, "TRUNCATE cargo_units CASCADE"); err != nil {
+		t.Fatalf("truncate error = %v", err)
+	}
+
+	return r
+}
+
+// appendEvent appends the event to the log of the unit.
+func appendEvent(ctx context.Context, r *Repository, id int64, event model.CargoUnitEvent) error {
+	_, _, err := r.AppendEvents(ctx, id, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		return []model.CargoUnitEvent{event}, nil
+	})
+	return err
+}
+
+func moved(latitude, longitude float64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{
+		Type:       model.CargoUnitEventMoved,
+		Location:   model.Location{Latitude: latitude, Longitude: longitude},
+		OccurredAt: time.Now(),
+	}
+}
+
+func reachedWarehouse(id, warehouseID int64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{
+		Type: model.CargoUnitEventReachedWarehouse,
+		UnitReachedWarehouse: model.UnitReachedWarehouse{
+			Location: model.Location{Latitude: 10, Longitude: 20},
+			Announcement: model.WarehouseAnnouncement{
+				CargoUnitId: id,
+				WarehouseId: warehouseID,
+				Message:     "arrived",
+			},
+		},
+		OccurredAt: time.Now(),
+	}
+}
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repository {
+		return openTestRepository(t)
+	})
+}
+
+func TestReportConformance(t *testing.T) {
+	repositorytest.RunReport(t, func(t *testing.T) repositorytest.Aggregator {
+		return openTestRepository(t)
+	})
+}
+
+func TestOpenMigratesOnce(t *testing.T) {
+	r := openTestRepository(t)
+
+	// the second replica finds the schema migrated
+	again, err := Open(context.Background(), os.Getenv(envTestDSN), PoolOptions{})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	again.Close()
+
+	list, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations() error = %v", err)
+	}
+	var applied int
+	if err := r.pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM schema_migrations").Scan(&applied); err != nil {
+		t.Fatalf("count migrations error = %v", err)
+	}
+	if applied != len(list) {
+		t.Fatalf("got %d applied migrations, want %d", applied, len(list))
+	}
+}
+
+func TestReport(t *testing.T) {
+	ctx := context.Background()
+	r := openTestRepository(t)
+
+	for id := int64(1); id <= 5; id++ {
+		if err := appendEvent(ctx, r, id, moved(1, 1)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+	for id, warehouseID := range map[int64]int64{2: 10, 3: 10, 5: 20} {
+		if err := appendEvent(ctx, r, id, reachedWarehouse(id, warehouseID)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	report, err := r.Report(ctx)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if report.DeliveryUnitsTotalNumber != 5 {
+		t.Fatalf("got %d units, want 5", report.DeliveryUnitsTotalNumber)
+	}
+	want := map[int64]int64{10: 2, 20: 1}
+	if len(report.DeliveryUnitsEachWarehouseReceivedTotalNumber) != len(want) {
+		t.Fatalf("got %+v, want %v", report.DeliveryUnitsEachWarehouseReceivedTotalNumber, want)
+	}
+	for _, total := range report.DeliveryUnitsEachWarehouseReceivedTotalNumber {
+		if want[total.WarehouseId] != total.DeliveryUnitsNumber {
+			t.Fatalf("got %+v, want %v", report.DeliveryUnitsEachWarehouseReceivedTotalNumber, want)
+		}
+	}
+}
+
+// TestAppendEventsConcurrentReplicasNoLostEvents runs writers over two pools the way two service replicas share the database.
+// The writers start on a missing unit, so they race to create its row as well as to lock it.
+func TestAppendEventsConcurrentReplicasNoLostEvents(t *testing.T) {
+	const (
+		writers = 10
+		appends = 20
+	)
+
+	ctx := context.Background()
+	replicas := []*Repository{openTestRepository(t)}
+	second, err := Open(ctx, os.Getenv(envTestDSN), PoolOptions{MaxConns: 20})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { second.Close() })
+	replicas = append(replicas, second)
+
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			<-start
+			r := replicas[w%len(replicas)]
+			for a := 0; a < appends; a++ {
+				if err := appendEvent(ctx, r, 1, moved(float64(w), float64(a))); err != nil {
+					t.Errorf("AppendEvents() error = %v", err)
+					return
+				}
+			}
+		}(w)
+	}
+	close(start)
+	wg.Wait()
+
+	events, err := replicas[1].ListEvents(ctx, 1, 0, writers*appends+1)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	if len(events) != writers*appends {
+		t.Fatalf("got %d events, want %d", len(events), writers*appends)
+	}
+	for i, event := range events {
+		if event.Sequence != int64(i)+1 {
+			t.Fatalf("got sequence %d at %d, want sequences without gaps", event.Sequence, i)
+		}
+	}
+
+	report, err := replicas[0].GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got, want := len(report.MoveUnit.Location), writers*appends; got != want {
+		t.Fatalf("got %d locations, want %d", got, want)
+	}
+	if got, want := report.Version, int64(writers*appends); got != want {
+		t.Fatalf("got version %d, want %d", got, want)
+	}
+}
+
+func TestStorageError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "serialization failure", err: &pgconn.PgError{Code: "40001"}, want: repository.ErrAborted},
+		{name: "deadlock", err: &pgconn.PgError{Code: "40P01"}, want: repository.ErrAborted},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, want: repository.ErrUnavailable},
+		{name: "starting up", err: &pgconn.PgError{Code: "57P03"}, want: repository.ErrUnavailable},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := storageError(tt.err)
+			for _, retryable := range []error{repository.ErrAborted, repository.ErrUnavailable} {
+				if errors.Is(err, retryable) != (retryable == tt.want) {
+					t.Fatalf("storageError() = %v, want %v", err, tt.want)
+				}
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("storageError() = %v, want it to wrap %v", err, tt.err)
+			}
+		})
+	}
+}
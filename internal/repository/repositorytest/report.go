@@ -0,0 +1,169 @@
+package repositorytest
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+)
+
+// Aggregator is implemented by repository backends aggregating metrics report themselves
+type Aggregator interface {
+	Repository
+	Report(ctx context.Context) (model.Report, error)
+	Size(ctx context.Context) (model.RepositorySize, error)
+}
+
+// RunReport runs the conformance tests of metrics report aggregation, newRepository must return an empty repository on every call.
+func RunReport(t *testing.T, newRepository func(t *testing.T) Aggregator) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, r Aggregator)
+	}{
+		{name: "ReportEmpty", test: testReportEmpty},
+		{name: "ReportInTransitUnits", test: testReportInTransitUnits},
+		{name: "ReportTravelStats", test: testReportTravelStats},
+		{name: "Size", test: testSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepository(t))
+		})
+	}
+}
+
+// degree is the length of a degree of longitude along the equator the service computes travel stats with
+const degree = 6371008.8 * math.Pi / 180
+
+func assertTravelStats(t *testing.T, got, want model.TravelStats) {
+	t.Helper()
+
+	for _, f := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"distance", got.DistanceMeters, want.DistanceMeters},
+		{"straight line", got.StraightLineMeters, want.StraightLineMeters},
+		{"timed distance", got.TimedDistanceMeters, want.TimedDistanceMeters},
+		{"timed seconds", got.TimedSeconds, want.TimedSeconds},
+		{"max speed", got.MaxSpeed, want.MaxSpeed},
+	} {
+		if math.Abs(f.got-f.want) > 1e-6 {
+			t.Fatalf("got %s %v, want %v", f.name, f.got, f.want)
+		}
+	}
+}
+
+func testReportEmpty(t *testing.T, r Aggregator) {
+	report, err := r.Report(context.Background())
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if report.DeliveryUnitsTotalNumber != 0 {
+		t.Fatalf("got %d units, want 0", report.DeliveryUnitsTotalNumber)
+	}
+	assertTravelStats(t, report.TravelStats, model.TravelStats{})
+}
+
+func testReportInTransitUnits(t *testing.T, r Aggregator) {
+	ctx := context.Background()
+
+	// units 1 and 4 are in transit, unit 2 visits warehouse 10 twice and unit 3 reaches warehouse 20
+	units := map[int64][]model.CargoUnitEvent{
+		1: {movedEvent(1, model.Location{Latitude: 1})},
+		2: {reachedWarehouseEvent(1, 2, 10), movedEvent(2, model.Location{Latitude: 2}), reachedWarehouseEvent(3, 2, 10)},
+		3: {movedEvent(1, model.Location{Latitude: 3}), reachedWarehouseEvent(2, 3, 20)},
+		4: {movedEvent(1, model.Location{Latitude: 4})},
+	}
+	for id, events := range units {
+		if _, _, err := r.AppendEvents(ctx, id, decideEvents(events...)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	report, err := r.Report(ctx)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if report.DeliveryUnitsTotalNumber != 4 {
+		t.Fatalf("got %d units, want 4", report.DeliveryUnitsTotalNumber)
+	}
+	if want := []int64{2, 3}; !slices.Equal(report.DeliveryUnitsReachedDestination, want) {
+		t.Fatalf("got units reached destination %v, want %v", report.DeliveryUnitsReachedDestination, want)
+	}
+	if want := []int64{1, 4}; !slices.Equal(report.InTransitUnits, want) {
+		t.Fatalf("got units in transit %v, want %v", report.InTransitUnits, want)
+	}
+	if want := []int64{10, 20}; !slices.Equal(report.WarehousesReceivedSuppliesList, want) {
+		t.Fatalf("got warehouses received supplies %v, want %v", report.WarehousesReceivedSuppliesList, want)
+	}
+	want := []model.DeliveryUnitsWarehouseReceivedTotalNumber{{WarehouseId: 10, DeliveryUnitsNumber: 2}, {WarehouseId: 20, DeliveryUnitsNumber: 1}}
+	if !slices.Equal(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, want) {
+		t.Fatalf("got warehouse totals %v, want %v", report.DeliveryUnitsEachWarehouseReceivedTotalNumber, want)
+	}
+}
+
+func testSize(t *testing.T, r Aggregator) {
+	ctx := context.Background()
+
+	size, err := r.Size(ctx)
+	if err != nil {
+		t.Fatalf("Size() error = %v", err)
+	}
+	if size != (model.RepositorySize{}) {
+		t.Fatalf("got size %+v of empty repository, want zero", size)
+	}
+
+	// unit 1 is in transit, unit 2 reached warehouse 10 and moved on
+	units := map[int64][]model.CargoUnitEvent{
+		1: {movedEvent(1, model.Location{Latitude: 1}), movedEvent(2, model.Location{Latitude: 2})},
+		2: {reachedWarehouseEvent(1, 2, 10), movedEvent(2, model.Location{Latitude: 3}), movedEvent(3, model.Location{Latitude: 4})},
+	}
+	for id, events := range units {
+		if _, _, err := r.AppendEvents(ctx, id, decideEvents(events...)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	size, err = r.Size(ctx)
+	if err != nil {
+		t.Fatalf("Size() error = %v", err)
+	}
+	if want := (model.RepositorySize{CargoUnits: 2, InTransitUnits: 1, Events: 5}); size != want {
+		t.Fatalf("got size %+v, want %+v", size, want)
+	}
+}
+
+func testReportTravelStats(t *testing.T, r Aggregator) {
+	ctx := context.Background()
+
+	// unit 1 has timed segments and an untimed one, unit 2 has not moved yet
+	// and unit 3 reported both locations at the same time, so it has no timed segments
+	appendEach(t, r, 1, moves(1,
+		model.Location{Longitude: 0, RecordedAt: seenAt(0)},
+		model.Location{Longitude: 1, RecordedAt: seenAt(0).Add(100 * time.Second)},
+		model.Location{Longitude: 3, ReceivedAt: seenAt(0).Add(200 * time.Second)},
+		model.Location{Longitude: 2},
+	)...)
+	appendEach(t, r, 2, moves(2, model.Location{ReceivedAt: seenAt(0)})...)
+	appendEach(t, r, 3, moves(3,
+		model.Location{Longitude: 0, RecordedAt: seenAt(1)},
+		model.Location{Longitude: 1, RecordedAt: seenAt(1)},
+	)...)
+
+	report, err := r.Report(ctx)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	assertTravelStats(t, report.TravelStats, model.TravelStats{
+		DistanceMeters:      5 * degree,
+		StraightLineMeters:  3 * degree,
+		TimedDistanceMeters: 3 * degree,
+		TimedSeconds:        200,
+		MaxSpeed:            2 * degree / 100,
+	})
+}
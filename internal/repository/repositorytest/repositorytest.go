@@ -0,0 +1,1236 @@
+// Package repositorytest checks that a repository backend keeps the semantics the service relies on,
+// the ones of memory.Repository. Backend tests call Run with a constructor of an empty repository.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+)
+
+// Repository is implemented by every repository backend
+type Repository interface {
+	GetAll(ctx context.Context) ([]model.MetricsReport, error)
+	List(ctx context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error)
+	GetByID(ctx context.Context, id int64) (model.MetricsReport, error)
+	Delete(ctx context.Context, id int64) error
+	AppendEvents(ctx context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error)
+	ListEvents(ctx context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error)
+	ListWarehouseVisits(ctx context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error)
+	Series(ctx context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error)
+}
+
+// Run runs the conformance tests, newRepository must return an empty repository on every call.
+func Run(t *testing.T, newRepository func(t *testing.T) Repository) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, r Repository)
+	}{
+		{name: "GetByIDNotFound", test: testGetByIDNotFound},
+		{name: "Delete", test: testDelete},
+		{name: "GetAll", test: testGetAll},
+		{name: "ListOrderAndPages", test: testListOrderAndPages},
+		{name: "ListFilter", test: testListFilter},
+		{name: "AppendEventsProjectsReport", test: testAppendEventsProjectsReport},
+		{name: "AppendEventsKeepsOrder", test: testAppendEventsKeepsOrder},
+		{name: "AppendEventsWarehouseVisits", test: testAppendEventsWarehouseVisits},
+		{name: "AppendEventsDepartedWarehouse", test: testAppendEventsDepartedWarehouse},
+		{name: "AppendEventsLateArrivals", test: testAppendEventsLateArrivals},
+		{name: "AppendEventsLateLocations", test: testAppendEventsLateLocations},
+		{name: "LocationAttributes", test: testLocationAttributes},
+		{name: "AppendEventsNothingDecided", test: testAppendEventsNothingDecided},
+		{name: "AppendEventsConflict", test: testAppendEventsConflict},
+		{name: "ListEventsPages", test: testListEventsPages},
+		{name: "DeleteRemovesEvents", test: testDeleteRemovesEvents},
+		{name: "AppendEventsConcurrentNoLostEvents", test: testAppendEventsConcurrentNoLostEvents},
+		{name: "AppendEventsConcurrentSingleWinner", test: testAppendEventsConcurrentSingleWinner},
+		{name: "ListWarehouseVisits", test: testListWarehouseVisits},
+		{name: "Series", test: testSeries},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepository(t))
+		})
+	}
+}
+
+// seenAt returns time every backend keeps exactly
+func seenAt(minutes int) time.Time {
+	return time.Date(2024, time.May, 1, 12, minutes, 0, 0, time.UTC)
+}
+
+// appendEach appends events to the log of the unit one at a time and returns the report projected from them
+func appendEach(t *testing.T, r Repository, id int64, events ...model.CargoUnitEvent) model.MetricsReport {
+	t.Helper()
+
+	events = slices.Clone(events)
+	for i := range events {
+		if _, _, err := r.AppendEvents(context.Background(), id, decideEvents(events[i])); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+		events[i].CargoUnitId, events[i].Sequence = id, int64(i)+1
+	}
+	return model.Project(id, events)
+}
+
+// moves returns events moving the unit with id through locations, seen at the minute of its id
+func moves(id int64, locations ...model.Location) []model.CargoUnitEvent {
+	events := make([]model.CargoUnitEvent, 0, len(locations))
+	for _, location := range locations {
+		events = append(events, movedEvent(int(id), location))
+	}
+	return events
+}
+
+func arrival(id, warehouseID int64) model.UnitReachedWarehouse {
+	return model.UnitReachedWarehouse{
+		Location: model.Location{Latitude: 50, Longitude: 30},
+		Announcement: model.WarehouseAnnouncement{
+			CargoUnitId: id,
+			WarehouseId: warehouseID,
+			Message:     "unloaded at the dock",
+		},
+	}
+}
+
+func movedEvent(minutes int, location model.Location) model.CargoUnitEvent {
+	return model.CargoUnitEvent{Type: model.CargoUnitEventMoved, Location: location, OccurredAt: seenAt(minutes)}
+}
+
+func reachedWarehouseEvent(minutes int, id, warehouseID int64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{Type: model.CargoUnitEventReachedWarehouse, UnitReachedWarehouse: arrival(id, warehouseID), OccurredAt: seenAt(minutes)}
+}
+
+func departedWarehouseEvent(minutes int, id, warehouseID int64) model.CargoUnitEvent {
+	return model.CargoUnitEvent{
+		Type: model.CargoUnitEventDepartedWarehouse,
+		UnitDepartedWarehouse: model.UnitDepartedWarehouse{
+			Location:     model.Location{Latitude: 50.5, Longitude: 30.5},
+			Announcement: model.WarehouseAnnouncement{CargoUnitId: id, WarehouseId: warehouseID, Message: "left the dock"},
+		},
+		OccurredAt: seenAt(minutes),
+	}
+}
+
+func decideEvents(events ...model.CargoUnitEvent) func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+	return func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		return events, nil
+	}
+}
+
+// assertEvents fails unless got holds the same events as want
+func assertEvents(t *testing.T, got, want []model.CargoUnitEvent) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d events %+v, want %d events %+v", len(got), got, len(want), want)
+	}
+	for i := range got {
+		g, w := got[i], want[i]
+		if !g.OccurredAt.Equal(w.OccurredAt) || !g.ReceivedAt.Equal(w.ReceivedAt) {
+			t.Fatalf("got event %d occurred at %v received at %v, want %v and %v", i, g.OccurredAt, g.ReceivedAt, w.OccurredAt, w.ReceivedAt)
+		}
+		if !g.Location.Equal(w.Location) || !g.UnitReachedWarehouse.Equal(w.UnitReachedWarehouse) || !g.UnitDepartedWarehouse.Equal(w.UnitDepartedWarehouse) {
+			t.Fatalf("got event %d at %+v, %+v, %+v, want %+v, %+v, %+v", i, g.Location, g.UnitReachedWarehouse, g.UnitDepartedWarehouse,
+				w.Location, w.UnitReachedWarehouse, w.UnitDepartedWarehouse)
+		}
+		g.OccurredAt, w.OccurredAt = time.Time{}, time.Time{}
+		g.ReceivedAt, w.ReceivedAt = time.Time{}, time.Time{}
+		g.Location, w.Location = model.Location{}, model.Location{}
+		g.UnitReachedWarehouse, w.UnitReachedWarehouse = model.UnitReachedWarehouse{}, model.UnitReachedWarehouse{}
+		g.UnitDepartedWarehouse, w.UnitDepartedWarehouse = model.UnitDepartedWarehouse{}, model.UnitDepartedWarehouse{}
+		if !reflect.DeepEqual(g, w) {
+			t.Fatalf("got event %d %+v, want %+v", i, g, w)
+		}
+	}
+}
+
+func visit(id, warehouseID int64, arrivedAt, departedAt time.Time) model.WarehouseVisit {
+	return model.WarehouseVisit{UnitReachedWarehouse: arrival(id, warehouseID), ArrivedAt: arrivedAt, DepartedAt: departedAt}
+}
+
+// assertReport fails unless got holds the same data as want, empty and nil tracks and visits are the same,
+// times are compared as instants
+func assertReport(t *testing.T, got, want model.MetricsReport) {
+	t.Helper()
+
+	if !got.LastSeenAt.Equal(want.LastSeenAt) {
+		t.Fatalf("got last seen at %v, want %v", got.LastSeenAt, want.LastSeenAt)
+	}
+	if !slices.EqualFunc(got.WarehouseVisits, want.WarehouseVisits, model.WarehouseVisit.Equal) {
+		t.Fatalf("got warehouse visits %+v, want %+v", got.WarehouseVisits, want.WarehouseVisits)
+	}
+	if !slices.EqualFunc(got.MoveUnit.Location, want.MoveUnit.Location, model.Location.Equal) {
+		t.Fatalf("got track %+v, want %+v", got.MoveUnit.Location, want.MoveUnit.Location)
+	}
+	if !got.UnitReachedWarehouse.Equal(want.UnitReachedWarehouse) {
+		t.Fatalf("got warehouse arrival %+v, want %+v", got.UnitReachedWarehouse, want.UnitReachedWarehouse)
+	}
+	got.LastSeenAt, want.LastSeenAt = time.Time{}, time.Time{}
+	got.WarehouseVisits, want.WarehouseVisits = nil, nil
+	got.MoveUnit.Location, want.MoveUnit.Location = nil, nil
+	got.UnitReachedWarehouse, want.UnitReachedWarehouse = model.UnitReachedWarehouse{}, model.UnitReachedWarehouse{}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got report %+v, want %+v", got, want)
+	}
+}
+
+func reportIDs(reports []model.MetricsReport) []int64 {
+	ids := make([]int64, 0, len(reports))
+	for _, report := range reports {
+		ids = append(ids, report.ID)
+	}
+	return ids
+}
+
+func testGetByIDNotFound(t *testing.T, r Repository) {
+	if _, err := r.GetByID(context.Background(), 1); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetByID() error = %v, want %v", err, repository.ErrNotFound)
+	}
+}
+
+func testDelete(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	if err := r.Delete(ctx, 1); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Delete() of missing report error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	appendEach(t, r, 1, movedEvent(1, model.Location{Latitude: 1}), reachedWarehouseEvent(2, 1, 2))
+	appendEach(t, r, 2, moves(2, model.Location{Latitude: 2})...)
+
+	if err := r.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := r.GetByID(ctx, 1); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetByID() of deleted report error = %v, want %v", err, repository.ErrNotFound)
+	}
+	if err := r.Delete(ctx, 1); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Delete() of deleted report error = %v, want %v", err, repository.ErrNotFound)
+	}
+	if _, err := r.GetByID(ctx, 2); err != nil {
+		t.Fatalf("GetByID() of other report error = %v", err)
+	}
+
+	// deleted report is created from scratch
+	appendEach(t, r, 1, movedEvent(5, model.Location{Latitude: 5}))
+	recreated, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	want := model.MetricsReport{ID: 1, MoveUnit: model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 5}}}, LastSeenAt: seenAt(5), Version: 1}
+	assertReport(t, recreated, want)
+}
+
+func testGetAll(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	reports, err := r.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("got %d reports of empty repository", len(reports))
+	}
+
+	want := map[int64]model.MetricsReport{}
+	for id := int64(1); id <= 5; id++ {
+		events := moves(id, model.Location{Latitude: float64(id)})
+		if id%2 == 0 {
+			events = append(events, reachedWarehouseEvent(int(id), id, id*10))
+		}
+		want[id] = appendEach(t, r, id, events...)
+	}
+
+	reports, err = r.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if len(reports) != len(want) {
+		t.Fatalf("got reports %v, want %d reports", reportIDs(reports), len(want))
+	}
+	// reports are returned in any order
+	for _, report := range reports {
+		assertReport(t, report, want[report.ID])
+	}
+}
+
+func testListOrderAndPages(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// created out of order
+	want := map[int64]model.MetricsReport{}
+	for _, id := range []int64{5, 1, 4, 2, 3} {
+		want[id] = appendEach(t, r, id, moves(id, model.Location{Latitude: float64(id)})...)
+	}
+
+	var got []int64
+	afterID := int64(0)
+	for {
+		reports, err := r.List(ctx, model.CargoUnitsFilter{}, afterID, 2)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(reports) > 2 {
+			t.Fatalf("got page %v longer than limit 2", reportIDs(reports))
+		}
+		if len(reports) == 0 {
+			break
+		}
+		for _, report := range reports {
+			assertReport(t, report, want[report.ID])
+		}
+		got = append(got, reportIDs(reports)...)
+		afterID = reports[len(reports)-1].ID
+	}
+
+	if wantIDs := []int64{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, wantIDs) {
+		t.Fatalf("got pages %v, want %v", got, wantIDs)
+	}
+}
+
+func testListFilter(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	warehouses := map[int64]int64{2: 10, 3: 10, 5: 20}
+	for id := int64(1); id <= 5; id++ {
+		events := moves(id, model.Location{Latitude: 1})
+		if warehouseID, exist := warehouses[id]; exist {
+			events = append(events, reachedWarehouseEvent(int(id), id, warehouseID))
+		}
+		appendEach(t, r, id, events...)
+	}
+
+	tests := []struct {
+		name    string
+		filter  model.CargoUnitsFilter
+		afterID int64
+		want    []int64
+	}{
+		{name: "any", want: []int64{1, 2, 3, 4, 5}},
+		{name: "after id", afterID: 3, want: []int64{4, 5}},
+		{name: "warehouse", filter: model.CargoUnitsFilter{WarehouseId: 10}, want: []int64{2, 3}},
+		{name: "unknown warehouse", filter: model.CargoUnitsFilter{WarehouseId: 30}},
+		{name: "in transit", filter: model.CargoUnitsFilter{Status: model.CargoUnitStatusInTransit}, want: []int64{1, 4}},
+		{name: "arrived", filter: model.CargoUnitsFilter{Status: model.CargoUnitStatusArrived}, want: []int64{2, 3, 5}},
+		{name: "arrived after id", filter: model.CargoUnitsFilter{Status: model.CargoUnitStatusArrived}, afterID: 2, want: []int64{3, 5}},
+		{name: "last seen after", filter: model.CargoUnitsFilter{LastSeenAfter: seenAt(3)}, want: []int64{4, 5}},
+		{name: "combined", filter: model.CargoUnitsFilter{WarehouseId: 20, Status: model.CargoUnitStatusArrived, LastSeenAfter: seenAt(3)}, want: []int64{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports, err := r.List(ctx, tt.filter, tt.afterID, 100)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if got := reportIDs(reports); len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testAppendEventsProjectsReport(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	report, events, err := r.AppendEvents(ctx, 1, func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		if report.ID != 1 || report.Version != 0 || len(report.MoveUnit.Location) != 0 || report.ReachedWarehouse() {
+			t.Errorf("missing report is given as %+v, want empty report with the id", report)
+		}
+		// the id of the unit is set by the repository
+		moved := movedEvent(1, model.Location{Latitude: 1, Longitude: 2})
+		moved.CargoUnitId = 2
+		return []model.CargoUnitEvent{moved, movedEvent(2, model.Location{Latitude: 3, Longitude: 4})}, nil
+	})
+	if err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	want := []model.CargoUnitEvent{
+		{Type: model.CargoUnitEventMoved, CargoUnitId: 1, Location: model.Location{Latitude: 1, Longitude: 2}, OccurredAt: seenAt(1), Sequence: 1},
+		{Type: model.CargoUnitEventMoved, CargoUnitId: 1, Location: model.Location{Latitude: 3, Longitude: 4}, OccurredAt: seenAt(2), Sequence: 2},
+	}
+	assertEvents(t, events, want)
+	if report.Version != 2 {
+		t.Fatalf("got version %d, want 2", report.Version)
+	}
+
+	report, events, err = r.AppendEvents(ctx, 1, func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		// the head of the report is enough to decide on
+		if report.Version != 2 || len(report.MoveUnit.Location) != 0 {
+			t.Errorf("stored report is given as %+v, want its head", report)
+		}
+		return []model.CargoUnitEvent{reachedWarehouseEvent(3, 1, 7)}, nil
+	})
+	if err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	reached := reachedWarehouseEvent(3, 1, 7)
+	reached.CargoUnitId, reached.Sequence = 1, 3
+	assertEvents(t, events, []model.CargoUnitEvent{reached})
+	want = append(want, reached)
+
+	wantReport := model.MetricsReport{
+		ID:                   1,
+		MoveUnit:             model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 1, Longitude: 2}, {Latitude: 3, Longitude: 4}}},
+		UnitReachedWarehouse: arrival(1, 7),
+		WarehouseVisits:      []model.WarehouseVisit{visit(1, 7, seenAt(3), time.Time{})},
+		LastSeenAt:           seenAt(3),
+		Version:              3,
+	}
+	// backends aggregating reports themselves may return the head only
+	assertReport(t, report.Head(), wantReport.Head())
+
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, wantReport)
+	if _, err := r.GetByID(ctx, 2); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetByID() of the id of the event error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	// the report is replayed from the log
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	assertEvents(t, logged, want)
+	assertReport(t, model.Project(1, logged), wantReport)
+}
+
+func testAppendEventsKeepsOrder(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// locations without time are appended in the order they were reported
+	var want []model.Location
+	for i := 0; i < 20; i++ {
+		location := model.Location{Latitude: float64(i % 7), Longitude: float64(i)}
+		want = append(want, location)
+
+		_, events, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(i, location)))
+		if err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+		if len(events) != 1 || events[0].Sequence != int64(i)+1 {
+			t.Fatalf("got events %+v, want a single event with sequence %d", events, i+1)
+		}
+	}
+
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, model.MetricsReport{ID: 1, MoveUnit: model.MoveUnit{CargoUnitId: 1, Location: want}, LastSeenAt: seenAt(19), Version: 20})
+
+	// late locations take their place in the track by time, after the locations of the same time
+	at := func(minutes int) model.Location {
+		return model.Location{Latitude: float64(minutes), RecordedAt: seenAt(minutes)}
+	}
+	second := model.Location{Latitude: 2.5, RecordedAt: seenAt(2)}
+	noTime := model.Location{Longitude: 1}
+	appendEach(t, r, 2, movedEvent(1, at(3)), movedEvent(2, at(1)))
+	if _, _, err := r.AppendEvents(ctx, 2, decideEvents(movedEvent(3, at(2)), movedEvent(4, second), movedEvent(5, noTime))); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	appendEach(t, r, 2, movedEvent(6, at(1)))
+
+	got, err = r.GetByID(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	// the location of minute 1 follows the location without time, as every location is after it
+	wantTrack := []model.Location{at(1), at(2), second, at(3), noTime, at(1)}
+	if !slices.EqualFunc(got.MoveUnit.Location, wantTrack, model.Location.Equal) {
+		t.Fatalf("got track %+v, want %+v", got.MoveUnit.Location, wantTrack)
+	}
+}
+
+func testAppendEventsWarehouseVisits(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// the unit departs with the next event after its arrival
+	events := []model.CargoUnitEvent{
+		movedEvent(1, model.Location{Latitude: 1}),
+		reachedWarehouseEvent(2, 1, 5),
+		movedEvent(3, model.Location{Latitude: 2}),
+		reachedWarehouseEvent(4, 1, 6),
+		reachedWarehouseEvent(5, 1, 5),
+	}
+	for _, event := range events {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(event)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	want := model.MetricsReport{
+		ID:                   1,
+		MoveUnit:             model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 1}, {Latitude: 2}}},
+		UnitReachedWarehouse: arrival(1, 5),
+		WarehouseVisits: []model.WarehouseVisit{
+			visit(1, 5, seenAt(2), seenAt(3)),
+			visit(1, 6, seenAt(4), seenAt(5)),
+			visit(1, 5, seenAt(5), time.Time{}),
+		},
+		LastSeenAt: seenAt(5),
+		Version:    5,
+	}
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, want)
+
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	assertReport(t, model.Project(1, logged), want)
+
+	// the last visit ends once the unit moves on
+	if _, _, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(6, model.Location{Latitude: 3}))); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	got, err = r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if n := len(got.WarehouseVisits); n != 3 || !got.WarehouseVisits[n-1].DepartedAt.Equal(seenAt(6)) {
+		t.Fatalf("got warehouse visits %+v, want the last one departed at %v", got.WarehouseVisits, seenAt(6))
+	}
+
+	// visits started and ended by a single append follow the stored ones
+	if _, _, err := r.AppendEvents(ctx, 1, func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		if report.StayingAt(5) || len(report.WarehouseVisits) != 1 {
+			t.Errorf("stored report is given as %+v, want its head with the last visit", report)
+		}
+		return []model.CargoUnitEvent{reachedWarehouseEvent(7, 1, 6), reachedWarehouseEvent(8, 1, 7)}, nil
+	}); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	got, err = r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	want.WarehouseVisits[2].DepartedAt = seenAt(6)
+	want.WarehouseVisits = append(want.WarehouseVisits, visit(1, 6, seenAt(7), seenAt(8)), visit(1, 7, seenAt(8), time.Time{}))
+	assertReport(t, got.Head(), model.MetricsReport{
+		ID:                   1,
+		UnitReachedWarehouse: arrival(1, 7),
+		WarehouseVisits:      want.WarehouseVisits[4:],
+		LastSeenAt:           seenAt(8),
+		Version:              8,
+	})
+	if !slices.EqualFunc(got.WarehouseVisits, want.WarehouseVisits, model.WarehouseVisit.Equal) {
+		t.Fatalf("got warehouse visits %+v, want %+v", got.WarehouseVisits, want.WarehouseVisits)
+	}
+}
+
+func testAppendEventsLateArrivals(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	appendEach(t, r, 1,
+		reachedWarehouseEvent(10, 1, 5),
+		reachedWarehouseEvent(20, 1, 6),
+		reachedWarehouseEvent(30, 1, 7),
+		// late arrivals take their place among the visits, ending the visit before them
+		reachedWarehouseEvent(15, 1, 8),
+		reachedWarehouseEvent(5, 1, 9),
+	)
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	// the unit is still staying at the warehouse it has reached last
+	if !got.StayingAt(7) || got.UnitReachedWarehouse.Announcement.WarehouseId != 7 {
+		t.Fatalf("got report %+v, want the unit staying at warehouse 7", got)
+	}
+
+	// a late arrival and a new one appended at once
+	if _, _, err := r.AppendEvents(ctx, 1, decideEvents(reachedWarehouseEvent(25, 1, 10), reachedWarehouseEvent(40, 1, 11))); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+
+	want := model.MetricsReport{
+		ID:                   1,
+		UnitReachedWarehouse: arrival(1, 11),
+		WarehouseVisits: []model.WarehouseVisit{
+			visit(1, 9, seenAt(5), seenAt(10)),
+			visit(1, 5, seenAt(10), seenAt(15)),
+			visit(1, 8, seenAt(15), seenAt(20)),
+			visit(1, 6, seenAt(20), seenAt(25)),
+			visit(1, 10, seenAt(25), seenAt(30)),
+			visit(1, 7, seenAt(30), seenAt(40)),
+			visit(1, 11, seenAt(40), time.Time{}),
+		},
+		LastSeenAt: seenAt(40),
+		Version:    7,
+	}
+	got, err = r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, want)
+
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	assertReport(t, model.Project(1, logged), want)
+}
+
+func testAppendEventsDepartedWarehouse(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// departure from a warehouse the unit is not staying at is logged without ending the visit
+	events := []model.CargoUnitEvent{
+		reachedWarehouseEvent(1, 1, 5),
+		departedWarehouseEvent(2, 1, 6),
+		departedWarehouseEvent(3, 1, 5),
+		movedEvent(4, model.Location{Latitude: 1}),
+	}
+	for _, event := range events {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(event)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	want := model.MetricsReport{
+		ID:                   1,
+		MoveUnit:             model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 1}}},
+		UnitReachedWarehouse: arrival(1, 5),
+		WarehouseVisits:      []model.WarehouseVisit{visit(1, 5, seenAt(1), seenAt(3))},
+		LastSeenAt:           seenAt(4),
+		Version:              4,
+	}
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, want)
+
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	for i := range events {
+		events[i].CargoUnitId = 1
+		events[i].Sequence = int64(i) + 1
+	}
+	assertEvents(t, logged, events)
+	assertReport(t, model.Project(1, logged), want)
+}
+
+func testAppendEventsLateLocations(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// the device recorded the second location before the first one, the last location has no device time
+	at := func(minutes int, latitude float64, recordedAt time.Time) model.CargoUnitEvent {
+		location := model.Location{Latitude: latitude, RecordedAt: recordedAt, ReceivedAt: seenAt(minutes)}
+		event := movedEvent(minutes, location)
+		event.OccurredAt, event.ReceivedAt = location.Time(), location.ReceivedAt
+		return event
+	}
+	events := []model.CargoUnitEvent{
+		at(10, 1, seenAt(5)),
+		at(11, 2, seenAt(3)),
+		at(12, 3, time.Time{}),
+		reachedWarehouseEvent(13, 1, 5),
+		at(14, 4, seenAt(4)),
+	}
+	for _, event := range events {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(event)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	want := model.MetricsReport{
+		ID: 1,
+		MoveUnit: model.MoveUnit{CargoUnitId: 1, Location: []model.Location{
+			events[1].Location,
+			events[4].Location,
+			events[0].Location,
+			events[2].Location,
+		}},
+		UnitReachedWarehouse: arrival(1, 5),
+		// the late location does not end the visit
+		WarehouseVisits: []model.WarehouseVisit{visit(1, 5, seenAt(13), time.Time{})},
+		LastSeenAt:      seenAt(14),
+		Version:         5,
+	}
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, want)
+
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	for i := range events {
+		events[i].CargoUnitId, events[i].Sequence = 1, int64(i+1)
+	}
+	assertEvents(t, logged, events)
+	assertReport(t, model.Project(1, logged), want)
+}
+
+func testLocationAttributes(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// coordinates of the southern and western hemispheres with fractions of a degree, zero attributes are reported too
+	value := func(f float64) *float64 { return &f }
+	moved := movedEvent(1, model.Location{
+		Latitude:  -33.8688,
+		Longitude: -70.6483,
+		Altitude:  value(520.5),
+		Accuracy:  value(4.2),
+		Heading:   value(0),
+		Speed:     value(13.9),
+	})
+	reached := reachedWarehouseEvent(2, 1, 5)
+	reached.UnitReachedWarehouse.Location = model.Location{Latitude: 51.5072, Longitude: -0.1276, Accuracy: value(15)}
+	events := []model.CargoUnitEvent{moved, reached}
+	for _, event := range events {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(event)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	want := model.MetricsReport{
+		ID:                   1,
+		MoveUnit:             model.MoveUnit{CargoUnitId: 1, Location: []model.Location{moved.Location}},
+		UnitReachedWarehouse: reached.UnitReachedWarehouse,
+		WarehouseVisits:      []model.WarehouseVisit{{UnitReachedWarehouse: reached.UnitReachedWarehouse, ArrivedAt: seenAt(2)}},
+		LastSeenAt:           seenAt(2),
+		Version:              2,
+	}
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, got, want)
+
+	logged, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	for i := range events {
+		events[i].CargoUnitId, events[i].Sequence = 1, int64(i+1)
+	}
+	assertEvents(t, logged, events)
+}
+
+func testAppendEventsNothingDecided(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	if _, _, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(1, model.Location{Latitude: 1}))); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+
+	errRejected := errors.New("rejected")
+	for _, id := range []int64{1, 2} {
+		_, _, err := r.AppendEvents(ctx, id, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+			return []model.CargoUnitEvent{movedEvent(2, model.Location{Latitude: 2})}, errRejected
+		})
+		if !errors.Is(err, errRejected) {
+			t.Fatalf("AppendEvents() error = %v, want %v", err, errRejected)
+		}
+
+		report, events, err := r.AppendEvents(ctx, id, decideEvents())
+		if err != nil {
+			t.Fatalf("AppendEvents() of no events error = %v", err)
+		}
+		if len(events) != 0 {
+			t.Fatalf("got appended events %+v, want none", events)
+		}
+		if report.ID != id {
+			t.Fatalf("got report %+v of unit %d", report, id)
+		}
+	}
+
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	want := model.MetricsReport{ID: 1, MoveUnit: model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 1}}}, LastSeenAt: seenAt(1), Version: 1}
+	assertReport(t, got, want)
+
+	events, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	if _, err := r.GetByID(ctx, 2); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetByID() of rejected report error = %v, want %v", err, repository.ErrNotFound)
+	}
+}
+
+func testAppendEventsConflict(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// decideVersion appends a move only to the report of version, the way writes with if_match are decided
+	decideVersion := func(version int64, minutes int) func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		return func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+			switch {
+			case report.Version == version:
+				return []model.CargoUnitEvent{movedEvent(minutes, model.Location{Latitude: float64(minutes)})}, nil
+			case version == 0:
+				return nil, repository.ErrAlreadyExists
+			}
+			return nil, repository.ErrConflict
+		}
+	}
+
+	if _, _, err := r.AppendEvents(ctx, 1, decideVersion(0, 1)); err != nil {
+		t.Fatalf("AppendEvents() creating the unit error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		version int64
+		want    error
+	}{
+		{name: "duplicate create", version: 0, want: repository.ErrAlreadyExists},
+		{name: "stale version", version: 2, want: repository.ErrConflict},
+		{name: "current version", version: 1},
+		{name: "version read before", version: 1, want: repository.ErrConflict},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, events, err := r.AppendEvents(ctx, 1, decideVersion(tt.version, i+2))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("AppendEvents() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil && (len(events) != 0 || report.Version != 0) {
+				t.Fatalf("got report %+v and events %+v of a failed append, want none", report, events)
+			}
+		})
+	}
+
+	// only the create and the move decided on the current version are saved
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	want := model.MetricsReport{
+		ID:         1,
+		MoveUnit:   model.MoveUnit{CargoUnitId: 1, Location: []model.Location{{Latitude: 1}, {Latitude: 4}}},
+		LastSeenAt: seenAt(4),
+		Version:    2,
+	}
+	assertReport(t, got, want)
+	events, err := r.ListEvents(ctx, 1, 0, 100)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	assertReport(t, model.Project(1, events), want)
+}
+
+func testListEventsPages(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	if _, err := r.ListEvents(ctx, 1, 0, 10); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("ListEvents() of missing unit error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(i, model.Location{Latitude: float64(i)}))); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+	// events of other units are not listed
+	if _, _, err := r.AppendEvents(ctx, 2, decideEvents(movedEvent(1, model.Location{Latitude: 9}))); err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+
+	var got []int64
+	afterSequence := int64(0)
+	for {
+		events, err := r.ListEvents(ctx, 1, afterSequence, 2)
+		if err != nil {
+			t.Fatalf("ListEvents() error = %v", err)
+		}
+		if len(events) > 2 {
+			t.Fatalf("got page of %d events longer than limit 2", len(events))
+		}
+		if len(events) == 0 {
+			break
+		}
+		for _, event := range events {
+			if event.CargoUnitId != 1 || event.Location.Latitude != float64(event.Sequence) {
+				t.Fatalf("got event %+v", event)
+			}
+			got = append(got, event.Sequence)
+		}
+		afterSequence = events[len(events)-1].Sequence
+	}
+
+	if want := []int64{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got pages %v, want %v", got, want)
+	}
+}
+
+func testDeleteRemovesEvents(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		if _, _, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(i, model.Location{Latitude: float64(i)}))); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+	if err := r.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := r.ListEvents(ctx, 1, 0, 10); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("ListEvents() of deleted unit error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	// the log of the deleted unit starts from scratch
+	_, events, err := r.AppendEvents(ctx, 1, decideEvents(movedEvent(5, model.Location{Latitude: 5})))
+	if err != nil {
+		t.Fatalf("AppendEvents() error = %v", err)
+	}
+	logged, err := r.ListEvents(ctx, 1, 0, 10)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	assertEvents(t, logged, events)
+	if len(logged) != 1 || logged[0].Sequence != 1 {
+		t.Fatalf("got events %+v, want a single event with sequence 1", logged)
+	}
+}
+
+// testAppendEventsConcurrentNoLostEvents is meant to be run with -race.
+func testAppendEventsConcurrentNoLostEvents(t *testing.T, r Repository) {
+	const (
+		writers = 8
+		appends = 25
+	)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for a := 0; a < appends; a++ {
+				// every writer appends to its own unit as well as to the shared one
+				for _, id := range []int64{1, int64(w) + 2} {
+					event := movedEvent(a, model.Location{Latitude: float64(w), Longitude: float64(a)})
+					if _, _, err := r.AppendEvents(ctx, id, decideEvents(event)); err != nil {
+						t.Errorf("AppendEvents() error = %v", err)
+						return
+					}
+				}
+			}
+		}(w)
+	}
+
+	// readers race with the writers
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for a := 0; a < appends; a++ {
+				if _, err := r.ListEvents(ctx, 1, 0, writers*appends); err != nil && !errors.Is(err, repository.ErrNotFound) {
+					t.Errorf("ListEvents() error = %v", err)
+					return
+				}
+				if _, err := r.GetByID(ctx, 1); err != nil && !errors.Is(err, repository.ErrNotFound) {
+					t.Errorf("GetByID() error = %v", err)
+					return
+				}
+				if _, err := r.List(ctx, model.CargoUnitsFilter{}, 0, 10); err != nil {
+					t.Errorf("List() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	events, err := r.ListEvents(ctx, 1, 0, writers*appends+1)
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	if len(events) != writers*appends {
+		t.Fatalf("got %d events, want %d", len(events), writers*appends)
+	}
+	for i, event := range events {
+		if event.Sequence != int64(i)+1 {
+			t.Fatalf("got sequence %d at %d, want sequences without gaps", event.Sequence, i)
+		}
+	}
+
+	report, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assertReport(t, model.Project(1, events), report)
+
+	seen := make(map[model.Location]bool, writers*appends)
+	for _, l := range report.MoveUnit.Location {
+		if seen[l] {
+			t.Fatalf("location %+v saved twice", l)
+		}
+		seen[l] = true
+	}
+
+	// locations of a writer keep the order it wrote them in
+	for w := 0; w < writers; w++ {
+		own, err := r.GetByID(ctx, int64(w)+2)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		if len(own.MoveUnit.Location) != appends || own.Version != appends {
+			t.Fatalf("got %d locations and version %d of writer %d, want %d", len(own.MoveUnit.Location), own.Version, w, appends)
+		}
+		for a, l := range own.MoveUnit.Location {
+			if l.Longitude != float64(a) {
+				t.Fatalf("got location %+v at %d of writer %d", l, a, w)
+			}
+		}
+	}
+}
+
+// testAppendEventsConcurrentSingleWinner checks that of the appends decided on the same version only one is saved.
+func testAppendEventsConcurrentSingleWinner(t *testing.T, r Repository) {
+	const writers = 8
+
+	ctx := context.Background()
+
+	appendEach(t, r, 1, movedEvent(1, model.Location{Latitude: -1}))
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		winners   []float64
+		conflicts int
+	)
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			_, _, err := r.AppendEvents(ctx, 1, func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+				if report.Version != 1 {
+					return nil, repository.ErrConflict
+				}
+				return []model.CargoUnitEvent{movedEvent(2, model.Location{Latitude: float64(w)})}, nil
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				winners = append(winners, float64(w))
+			case errors.Is(err, repository.ErrConflict):
+				conflicts++
+			default:
+				t.Errorf("AppendEvents() error = %v", err)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if len(winners) != 1 || conflicts != writers-1 {
+		t.Fatalf("got %d saved appends and %d conflicts, want 1 and %d", len(winners), conflicts, writers-1)
+	}
+
+	got, err := r.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.Version != 2 || len(got.MoveUnit.Location) != 2 || got.MoveUnit.Location[1].Latitude != winners[0] {
+		t.Fatalf("got %+v, want append of writer %v", got, winners[0])
+	}
+}
+
+func testSeries(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// units 1 and 2 travel within the first day, unit 3 reaches a warehouse the next day
+	logs := map[int64][]model.CargoUnitEvent{
+		1: {
+			movedEvent(0, model.Location{Latitude: 1}),
+			reachedWarehouseEvent(30, 1, 5),
+			movedEvent(70, model.Location{Latitude: 2}),
+			reachedWarehouseEvent(80, 1, 6),
+		},
+		2: {
+			movedEvent(10, model.Location{Latitude: 1}),
+			reachedWarehouseEvent(65, 2, 5),
+		},
+		3: {
+			reachedWarehouseEvent(24*60+5, 3, 6),
+		},
+	}
+	for id, events := range logs {
+		if _, _, err := r.AppendEvents(ctx, id, decideEvents(events...)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	hour := func(minutes int) time.Time { return seenAt(minutes).Truncate(time.Hour) }
+	day := func(minutes int) time.Time { return seenAt(minutes).Truncate(24 * time.Hour) }
+
+	tests := []struct {
+		name   string
+		filter model.SeriesFilter
+		want   []model.SeriesBucket
+	}{
+		{
+			name:   "not grouped",
+			filter: model.SeriesFilter{},
+			want:   nil,
+		},
+		{
+			name:   "by hour",
+			filter: model.SeriesFilter{GroupBy: model.SeriesGroupByHour},
+			want: []model.SeriesBucket{
+				{Start: hour(0), Arrivals: 1, ActiveUnits: 2},
+				{Start: hour(60), Arrivals: 2, ActiveUnits: 2},
+				{Start: hour(24*60 + 5), Arrivals: 1, ActiveUnits: 1},
+			},
+		},
+		{
+			name:   "by day",
+			filter: model.SeriesFilter{GroupBy: model.SeriesGroupByDay},
+			want: []model.SeriesBucket{
+				{Start: day(0), Arrivals: 3, ActiveUnits: 2},
+				{Start: day(24*60 + 5), Arrivals: 1, ActiveUnits: 1},
+			},
+		},
+		{
+			name:   "by warehouse",
+			filter: model.SeriesFilter{GroupBy: model.SeriesGroupByWarehouse},
+			want: []model.SeriesBucket{
+				{WarehouseId: 5, Arrivals: 2, ActiveUnits: 2},
+				{WarehouseId: 6, Arrivals: 2, ActiveUnits: 2},
+			},
+		},
+		{
+			name:   "time window excludes to",
+			filter: model.SeriesFilter{From: seenAt(30), To: seenAt(70), GroupBy: model.SeriesGroupByHour},
+			want: []model.SeriesBucket{
+				{Start: hour(30), Arrivals: 1, ActiveUnits: 1},
+				{Start: hour(65), Arrivals: 1, ActiveUnits: 1},
+			},
+		},
+		{
+			name:   "by warehouse in time window",
+			filter: model.SeriesFilter{From: seenAt(60), To: seenAt(24 * 60), GroupBy: model.SeriesGroupByWarehouse},
+			want: []model.SeriesBucket{
+				{WarehouseId: 5, Arrivals: 1, ActiveUnits: 1},
+				{WarehouseId: 6, Arrivals: 1, ActiveUnits: 1},
+			},
+		},
+		{
+			name:   "warehouse filter counts arrivals only",
+			filter: model.SeriesFilter{WarehouseIds: []int64{6}, GroupBy: model.SeriesGroupByHour},
+			want: []model.SeriesBucket{
+				{Start: hour(80), Arrivals: 1, ActiveUnits: 1},
+				{Start: hour(24*60 + 5), Arrivals: 1, ActiveUnits: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Series(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("Series() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got buckets %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || got[i].Start.Location() != time.UTC ||
+					got[i].WarehouseId != tt.want[i].WarehouseId || got[i].Arrivals != tt.want[i].Arrivals || got[i].ActiveUnits != tt.want[i].ActiveUnits {
+					t.Fatalf("got buckets %+v, want %+v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func testListWarehouseVisits(t *testing.T, r Repository) {
+	ctx := context.Background()
+
+	// unit 1 stays at warehouse 5 from 10 to 20 and at warehouse 6 since 30, unit 2 stays at warehouse 5 from 15 to 40
+	logs := map[int64][]model.CargoUnitEvent{
+		1: {reachedWarehouseEvent(10, 1, 5), departedWarehouseEvent(20, 1, 5), reachedWarehouseEvent(30, 1, 6)},
+		2: {reachedWarehouseEvent(15, 2, 5), movedEvent(40, model.Location{Latitude: 1})},
+		3: {movedEvent(5, model.Location{Latitude: 1})},
+	}
+	for id, events := range logs {
+		if _, _, err := r.AppendEvents(ctx, id, decideEvents(events...)); err != nil {
+			t.Fatalf("AppendEvents() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter model.WarehouseVisitsFilter
+		want   []model.WarehouseVisit
+	}{
+		{
+			name:   "every visit",
+			filter: model.WarehouseVisitsFilter{},
+			want: []model.WarehouseVisit{
+				visit(1, 5, seenAt(10), seenAt(20)),
+				visit(1, 6, seenAt(30), time.Time{}),
+				visit(2, 5, seenAt(15), seenAt(40)),
+			},
+		},
+		{
+			name:   "warehouse",
+			filter: model.WarehouseVisitsFilter{WarehouseId: 5},
+			want: []model.WarehouseVisit{
+				visit(1, 5, seenAt(10), seenAt(20)),
+				visit(2, 5, seenAt(15), seenAt(40)),
+			},
+		},
+		{
+			name:   "not departed before",
+			filter: model.WarehouseVisitsFilter{NotDepartedBefore: seenAt(21)},
+			want: []model.WarehouseVisit{
+				visit(1, 6, seenAt(30), time.Time{}),
+				visit(2, 5, seenAt(15), seenAt(40)),
+			},
+		},
+		{
+			name:   "departed at the time",
+			filter: model.WarehouseVisitsFilter{WarehouseId: 5, NotDepartedBefore: seenAt(20)},
+			want: []model.WarehouseVisit{
+				visit(1, 5, seenAt(10), seenAt(20)),
+				visit(2, 5, seenAt(15), seenAt(40)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.ListWarehouseVisits(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("ListWarehouseVisits() error = %v", err)
+			}
+			if !slices.EqualFunc(got, tt.want, model.WarehouseVisit.Equal) {
+				t.Fatalf("got visits %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+-- cargo_unit_events keeps the immutable log of every unit, reports are projections of the log.
+-- type is 1 for unit moved and 2 for unit reached warehouse events, occurred_at is unix time in nanoseconds
+CREATE TABLE cargo_unit_events (
+    cargo_unit_id INTEGER NOT NULL REFERENCES cargo_units (id) ON DELETE CASCADE,
+    sequence      INTEGER NOT NULL,
+    type          INTEGER NOT NULL,
+    occurred_at   INTEGER NOT NULL,
+    latitude      INTEGER NOT NULL,
+    longitude     INTEGER NOT NULL,
+    warehouse_id  INTEGER,
+    message       TEXT,
+    PRIMARY KEY (cargo_unit_id, sequence)
+) WITHOUT ROWID;
@@ -0,0 +1,23 @@
+-- latitude and longitude are WGS84 degrees. Columns of INTEGER affinity keep fractional values as REAL,
+-- so the stored whole degrees are kept as they are. altitude, accuracy, heading and speed are NULL
+-- unless reported by the device.
+
+ALTER TABLE locations ADD COLUMN altitude REAL;
+ALTER TABLE locations ADD COLUMN accuracy REAL;
+ALTER TABLE locations ADD COLUMN heading REAL;
+ALTER TABLE locations ADD COLUMN speed REAL;
+
+ALTER TABLE warehouse_arrivals ADD COLUMN altitude REAL;
+ALTER TABLE warehouse_arrivals ADD COLUMN accuracy REAL;
+ALTER TABLE warehouse_arrivals ADD COLUMN heading REAL;
+ALTER TABLE warehouse_arrivals ADD COLUMN speed REAL;
+
+ALTER TABLE warehouse_visits ADD COLUMN altitude REAL;
+ALTER TABLE warehouse_visits ADD COLUMN accuracy REAL;
+ALTER TABLE warehouse_visits ADD COLUMN heading REAL;
+ALTER TABLE warehouse_visits ADD COLUMN speed REAL;
+
+ALTER TABLE cargo_unit_events ADD COLUMN altitude REAL;
+ALTER TABLE cargo_unit_events ADD COLUMN accuracy REAL;
+ALTER TABLE cargo_unit_events ADD COLUMN heading REAL;
+ALTER TABLE cargo_unit_events ADD COLUMN speed REAL;
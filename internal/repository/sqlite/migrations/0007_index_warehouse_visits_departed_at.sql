@@ -0,0 +1,3 @@
+-- type of cargo_unit_events is 3 for unit departed warehouse events, they keep the warehouse and the location
+-- the way unit reached warehouse events do. Warehouse stats are computed from the visits not ended before the time window.
+CREATE INDEX warehouse_visits_departed_at ON warehouse_visits (departed_at);
@@ -0,0 +1,1093 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	driver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Repository keeps reports in SQLite database file, normalized into cargo_units, locations and warehouse_arrivals tables,
+// along with the log of events the reports are projected from in cargo_unit_events table.
+// Writes of the process are serialized, readers see the last committed state.
+type Repository struct {
+	db *sql.DB
+	// writeMu keeps writers of the process from failing each other with SQLITE_BUSY
+	writeMu sync.Mutex
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Open opens database file at path, creating it if missing, and migrates its schema to the latest version.
+func Open(ctx context.Context, path string) (*Repository, error) {
+	const opLabel = "sqlite.Open"
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "synchronous(NORMAL)")
+	query.Add("_pragma", "busy_timeout(5000)")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	if err := migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+// Close closes the database.
+func (r *Repository) Close() error {
+	return r.db.Close()
+}
+
+// GetAll returns all report data.
+func (r *Repository) GetAll(ctx context.Context) ([]model.MetricsReport, error) {
+	const opLabel = "sqlite.Repository.GetAll"
+
+	var reports []model.MetricsReport
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		var err error
+		reports, err = getReports(ctx, tx, "", nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return reports, nil
+}
+
+// List returns up to limit reports matching filter with id greater than afterID, ordered by id.
+func (r *Repository) List(ctx context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error) {
+	const opLabel = "sqlite.Repository.List"
+
+	conditions := []string{"u.id > ?"}
+	args := []any{afterID}
+	if filter.WarehouseId != 0 {
+		conditions = append(conditions, "a.warehouse_id = ?")
+		args = append(args, filter.WarehouseId)
+	}
+	switch filter.Status {
+	case model.CargoUnitStatusInTransit:
+		conditions = append(conditions, "a.cargo_unit_id IS NULL")
+	case model.CargoUnitStatusArrived:
+		conditions = append(conditions, "a.cargo_unit_id IS NOT NULL")
+	}
+	if !filter.LastSeenAfter.IsZero() {
+		conditions = append(conditions, "u.last_seen_at > ?")
+		args = append(args, filter.LastSeenAfter.UnixNano())
+	}
+	args = append(args, limit)
+
+	where := strings.Join(conditions, " AND ") + " ORDER BY u.id LIMIT ?"
+
+	var reports []model.MetricsReport
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		var err error
+		reports, err = getReports(ctx, tx, where, args)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return reports, nil
+}
+
+// GetByID returns report data by id.
+func (r *Repository) GetByID(ctx context.Context, id int64) (model.MetricsReport, error) {
+	const opLabel = "sqlite.Repository.GetByID"
+
+	var report model.MetricsReport
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		var err error
+		report, err = getReport(ctx, tx, id)
+		return err
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return model.MetricsReport{}, err
+	}
+	if err != nil {
+		return model.MetricsReport{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return report, nil
+}
+
+// AppendEvents atomically appends events returned by decide to the log of the unit and applies them to its report.
+// decide is given the head of the current report, or an empty report with the id if there is none. Nothing is saved
+// if decide returns an error or no events. Only the head of the report is loaded and returned, the rows of the events
+// are inserted into the track and the visits, so appending takes the same time however long the track is.
+func (r *Repository) AppendEvents(ctx context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error) {
+	const opLabel = "sqlite.Repository.AppendEvents"
+
+	var report model.MetricsReport
+	var events []model.CargoUnitEvent
+	var decideErr error
+	err := r.write(ctx, func(tx *sql.Tx) error {
+		stored, visitSeq, err := getHead(ctx, tx, id)
+		exist := err == nil
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+
+		report = model.MetricsReport{ID: id}
+		if exist {
+			report = stored
+		}
+
+		events, decideErr = decide(report)
+		if decideErr != nil {
+			return decideErr
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		// late arrivals are inserted among the visits the head does not hold
+		if at, late := stored.LateArrival(events); late {
+			stored.WarehouseVisits, visitSeq, err = getVisitsFrom(ctx, tx, id, at)
+			if err != nil {
+				return err
+			}
+			report = stored
+		}
+
+		// the loaded head is kept to update only the visits the events have changed
+		report = report.Clone()
+		events = slices.Clone(events)
+		for i := range events {
+			events[i].CargoUnitId = id
+			events[i].Sequence = report.Version + 1
+			report.Apply(events[i])
+		}
+
+		if !exist {
+			err = saveHead(ctx, tx, nil, visitSeq, report)
+		} else {
+			err = saveHead(ctx, tx, &stored, visitSeq, report)
+		}
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if event.Type != model.CargoUnitEventMoved {
+				continue
+			}
+			if err := insertLocation(ctx, tx, id, event.Location); err != nil {
+				return err
+			}
+		}
+		if err := saveEvents(ctx, tx, events); err != nil {
+			return err
+		}
+		report = report.Head()
+		return nil
+	})
+	if decideErr != nil {
+		return model.MetricsReport{}, nil, decideErr
+	}
+	if err != nil {
+		return model.MetricsReport{}, nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+	if len(events) == 0 {
+		return report, nil, nil
+	}
+
+	return report, events, nil
+}
+
+// ListEvents returns up to limit events of the unit with sequence greater than afterSequence, ordered by sequence.
+func (r *Repository) ListEvents(ctx context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error) {
+	const opLabel = "sqlite.Repository.ListEvents"
+
+	var events []model.CargoUnitEvent
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		var exist bool
+		if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM cargo_units WHERE id = ?)", id).Scan(&exist); err != nil {
+			return err
+		}
+		if !exist {
+			return repository.ErrNotFound
+		}
+
+		rows, err := tx.QueryContext(ctx, `
+			SELECT sequence, type, occurred_at, warehouse_id, message,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at
+			FROM cargo_unit_events
+			WHERE cargo_unit_id = ? AND sequence > ?
+			ORDER BY sequence
+			LIMIT ?`,
+			id, afterSequence, limit,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				event       = model.CargoUnitEvent{CargoUnitId: id}
+				occurredAt  int64
+				warehouseID sql.NullInt64
+				message     sql.NullString
+				row         locationRow
+			)
+			if err := rows.Scan(append([]any{&event.Sequence, &event.Type, &occurredAt, &warehouseID, &message}, row.dest()...)...); err != nil {
+				return err
+			}
+			event.OccurredAt = time.Unix(0, occurredAt)
+			// the location is received along with the event
+			location := row.location()
+			event.ReceivedAt = location.ReceivedAt
+			switch event.Type {
+			case model.CargoUnitEventMoved:
+				event.Location = location
+			case model.CargoUnitEventReachedWarehouse:
+				event.UnitReachedWarehouse = model.UnitReachedWarehouse{
+					Location: location,
+					Announcement: model.WarehouseAnnouncement{
+						CargoUnitId: id,
+						WarehouseId: warehouseID.Int64,
+						Message:     message.String,
+					},
+				}
+			case model.CargoUnitEventDepartedWarehouse:
+				event.UnitDepartedWarehouse = model.UnitDepartedWarehouse{
+					Location: location,
+					Announcement: model.WarehouseAnnouncement{
+						CargoUnitId: id,
+						WarehouseId: warehouseID.Int64,
+						Message:     message.String,
+					},
+				}
+			}
+			events = append(events, event)
+		}
+		return rows.Err()
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return events, nil
+}
+
+// Delete deletes report data along with the events of the unit.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const opLabel = "sqlite.Repository.Delete"
+
+	err := r.write(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, "DELETE FROM cargo_units WHERE id = ?", id)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return repository.ErrNotFound
+		}
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return nil
+}
+
+// Report aggregates metrics report in the database.
+func (r *Repository) Report(ctx context.Context) (model.Report, error) {
+	const opLabel = "sqlite.Repository.Report"
+
+	report := model.Report{
+		WarehousesReceivedSuppliesList:                []int64{},
+		DeliveryUnitsReachedDestination:               []int64{},
+		InTransitUnits:                                []int64{},
+		DeliveryUnitsEachWarehouseReceivedTotalNumber: []model.DeliveryUnitsWarehouseReceivedTotalNumber{},
+	}
+
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM cargo_units").Scan(&report.DeliveryUnitsTotalNumber); err != nil {
+			return err
+		}
+
+		// every visit is counted, units saved without visits are counted under their last arrival
+		// and units in transit are not counted, the same way the service aggregates reports itself
+		rows, err := tx.QueryContext(ctx, `
+			SELECT warehouse_id, COUNT(*)
+			FROM (
+				SELECT warehouse_id FROM warehouse_visits
+				UNION ALL
+				SELECT a.warehouse_id
+				FROM warehouse_arrivals a
+				WHERE NOT EXISTS (SELECT 1 FROM warehouse_visits v WHERE v.cargo_unit_id = a.cargo_unit_id)
+			)
+			GROUP BY warehouse_id
+			ORDER BY warehouse_id`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var total model.DeliveryUnitsWarehouseReceivedTotalNumber
+			if err := rows.Scan(&total.WarehouseId, &total.DeliveryUnitsNumber); err != nil {
+				return err
+			}
+			report.WarehousesReceivedSuppliesList = append(report.WarehousesReceivedSuppliesList, total.WarehouseId)
+			report.DeliveryUnitsEachWarehouseReceivedTotalNumber = append(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, total)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		// units that have reached a warehouse have their last arrival saved, the others are in transit
+		rows, err = tx.QueryContext(ctx, `
+			SELECT u.id, a.cargo_unit_id IS NOT NULL
+			FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id
+			ORDER BY u.id`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				id      int64
+				reached bool
+			)
+			if err := rows.Scan(&id, &reached); err != nil {
+				return err
+			}
+			if reached {
+				report.DeliveryUnitsReachedDestination = append(report.DeliveryUnitsReachedDestination, id)
+			} else {
+				report.InTransitUnits = append(report.InTransitUnits, id)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		stats := &report.TravelStats
+		return tx.QueryRowContext(ctx, travelStatsQuery, earthRadiusMeters).Scan(
+			&stats.DistanceMeters, &stats.StraightLineMeters, &stats.TimedDistanceMeters, &stats.TimedSeconds, &stats.MaxSpeed,
+		)
+	})
+	if err != nil {
+		return model.Report{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return report, nil
+}
+
+// Size counts units in the database and their events.
+func (r *Repository) Size(ctx context.Context) (model.RepositorySize, error) {
+	const opLabel = "sqlite.Repository.Size"
+
+	var size model.RepositorySize
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		// units in transit have no warehouse arrival saved
+		return tx.QueryRowContext(ctx, `
+			SELECT
+				(SELECT COUNT(*) FROM cargo_units),
+				(SELECT COUNT(*) FROM cargo_units u WHERE NOT EXISTS (SELECT 1 FROM warehouse_arrivals a WHERE a.cargo_unit_id = u.id)),
+				(SELECT COUNT(*) FROM cargo_unit_events)`).Scan(&size.CargoUnits, &size.InTransitUnits, &size.Events)
+	})
+	if err != nil {
+		return model.RepositorySize{}, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return size, nil
+}
+
+// ListWarehouseVisits returns warehouse visits matching filter, ordered by unit id and arrival.
+func (r *Repository) ListWarehouseVisits(ctx context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error) {
+	const opLabel = "sqlite.Repository.ListWarehouseVisits"
+
+	conditions := []string{"1 = 1"}
+	var args []any
+	if filter.WarehouseId != 0 {
+		conditions = append(conditions, "warehouse_id = ?")
+		args = append(args, filter.WarehouseId)
+	}
+	if !filter.NotDepartedBefore.IsZero() {
+		conditions = append(conditions, "(departed_at IS NULL OR departed_at >= ?)")
+		args = append(args, filter.NotDepartedBefore.UnixNano())
+	}
+
+	var visits []model.WarehouseVisit
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT `+warehouseVisitColumns+`
+			FROM warehouse_visits
+			WHERE `+strings.Join(conditions, " AND ")+`
+			ORDER BY cargo_unit_id, seq`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			visit, err := scanWarehouseVisit(rows)
+			if err != nil {
+				return err
+			}
+			visits = append(visits, visit)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return visits, nil
+}
+
+// Series counts events of every unit matching filter into its buckets in the database.
+func (r *Repository) Series(ctx context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error) {
+	const opLabel = "sqlite.Repository.Series"
+
+	// hours and days start in UTC as occurred_at is unix nanoseconds
+	var key string
+	switch filter.GroupBy {
+	case model.SeriesGroupByHour:
+		key = fmt.Sprintf("occurred_at - occurred_at %% %d, 0", time.Hour.Nanoseconds())
+	case model.SeriesGroupByDay:
+		key = fmt.Sprintf("occurred_at - occurred_at %% %d, 0", (24 * time.Hour).Nanoseconds())
+	case model.SeriesGroupByWarehouse:
+		key = "0, warehouse_id"
+	default:
+		return []model.SeriesBucket{}, nil
+	}
+
+	conditions := []string{"1 = 1"}
+	args := []any{model.CargoUnitEventReachedWarehouse}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "occurred_at >= ?")
+		args = append(args, filter.From.UnixNano())
+	}
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "occurred_at < ?")
+		args = append(args, filter.To.UnixNano())
+	}
+	if len(filter.WarehouseIds) > 0 || filter.GroupBy == model.SeriesGroupByWarehouse {
+		conditions = append(conditions, "type = ?")
+		args = append(args, model.CargoUnitEventReachedWarehouse)
+	}
+	if len(filter.WarehouseIds) > 0 {
+		conditions = append(conditions, "warehouse_id IN (?"+strings.Repeat(", ?", len(filter.WarehouseIds)-1)+")")
+		for _, id := range filter.WarehouseIds {
+			args = append(args, id)
+		}
+	}
+
+	buckets := []model.SeriesBucket{}
+	err := r.read(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT `+key+`, SUM(type = ?), COUNT(DISTINCT cargo_unit_id)
+			FROM cargo_unit_events
+			WHERE `+strings.Join(conditions, " AND ")+`
+			GROUP BY 1, 2
+			ORDER BY 1, 2`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				bucket model.SeriesBucket
+				start  int64
+			)
+			if err := rows.Scan(&start, &bucket.WarehouseId, &bucket.Arrivals, &bucket.ActiveUnits); err != nil {
+				return err
+			}
+			if filter.GroupBy != model.SeriesGroupByWarehouse {
+				bucket.Start = time.Unix(0, start).UTC()
+			}
+			buckets = append(buckets, bucket)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return buckets, nil
+}
+
+// earthRadiusMeters is the mean radius of the WGS84 ellipsoid the service computes travel stats of a unit with
+const earthRadiusMeters = 6371008.8
+
+// travelStatsQuery adds up travel stats of the tracks of every unit the way the service computes them for a unit:
+// haversine distances between consecutive locations, speeds over segments between locations of increasing time only.
+const travelStatsQuery = `
+	WITH points AS (
+		SELECT cargo_unit_id, seq, radians(latitude) AS lat, radians(longitude) AS lng,
+			COALESCE(recorded_at, received_at) AS at
+		FROM locations
+	),
+	segments AS (
+		SELECT 2 * ?1 * asin(min(1, sqrt(
+				power(sin((b.lat - a.lat) / 2), 2) + cos(a.lat) * cos(b.lat) * power(sin((b.lng - a.lng) / 2), 2)
+			))) AS meters,
+			(b.at - a.at) / 1e9 AS seconds
+		FROM points a JOIN points b ON b.cargo_unit_id = a.cargo_unit_id AND b.seq = a.seq + 1
+	),
+	ends AS (
+		SELECT 2 * ?1 * asin(min(1, sqrt(
+				power(sin((b.lat - a.lat) / 2), 2) + cos(a.lat) * cos(b.lat) * power(sin((b.lng - a.lng) / 2), 2)
+			))) AS meters
+		FROM (SELECT cargo_unit_id, MAX(seq) AS seq FROM locations GROUP BY cargo_unit_id) last
+		JOIN points a ON a.cargo_unit_id = last.cargo_unit_id AND a.seq = 0
+		JOIN points b ON b.cargo_unit_id = last.cargo_unit_id AND b.seq = last.seq
+	)
+	SELECT
+		(SELECT COALESCE(SUM(meters), 0) FROM segments),
+		(SELECT COALESCE(SUM(meters), 0) FROM ends),
+		(SELECT COALESCE(SUM(meters), 0) FROM segments WHERE seconds > 0),
+		(SELECT COALESCE(SUM(seconds), 0) FROM segments WHERE seconds > 0),
+		(SELECT COALESCE(MAX(meters / seconds), 0) FROM segments WHERE seconds > 0)`
+
+// read runs fn in a transaction, so every query of fn sees the same state.
+func (r *Repository) read(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return storageError(err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return storageError(err)
+	}
+
+	return storageError(tx.Commit())
+}
+
+// write runs fn in a transaction and commits it if fn succeeds.
+func (r *Repository) write(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storageError(err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return storageError(err)
+	}
+
+	return storageError(tx.Commit())
+}
+
+// storageError marks errors of the database locked by another connection as retryable.
+func storageError(err error) error {
+	var sqliteErr *driver.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() & 0xff {
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+			return fmt.Errorf("%w: %w", repository.ErrUnavailable, err)
+		}
+	}
+	return err
+}
+
+// getReport returns report with id or repository.ErrNotFound.
+func getReport(ctx context.Context, q querier, id int64) (model.MetricsReport, error) {
+	reports, err := getReports(ctx, q, "u.id = ?", []any{id})
+	if err != nil {
+		return model.MetricsReport{}, err
+	}
+	if len(reports) == 0 {
+		return model.MetricsReport{}, repository.ErrNotFound
+	}
+	return reports[0], nil
+}
+
+// getHead returns the head of the report with id along with the seq of its last visit, 0 if it has none,
+// or repository.ErrNotFound.
+func getHead(ctx context.Context, q querier, id int64) (model.MetricsReport, int, error) {
+	report, err := scanReport(q.QueryRowContext(ctx, `
+		SELECT `+reportColumns+`
+		FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id
+		WHERE u.id = ?`,
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.MetricsReport{}, 0, repository.ErrNotFound
+	}
+	if err != nil {
+		return model.MetricsReport{}, 0, err
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT `+warehouseVisitColumns+`, seq
+		FROM warehouse_visits
+		WHERE cargo_unit_id = ?
+		ORDER BY seq DESC
+		LIMIT 1`,
+		id,
+	)
+	if err != nil {
+		return model.MetricsReport{}, 0, err
+	}
+	defer rows.Close()
+
+	var seq int
+	for rows.Next() {
+		visit, err := scanWarehouseVisit(rows, &seq)
+		if err != nil {
+			return model.MetricsReport{}, 0, err
+		}
+		report.WarehouseVisits = []model.WarehouseVisit{visit}
+	}
+
+	return report, seq, rows.Err()
+}
+
+// getVisitsFrom returns the visits of the unit from the last one that began by at, or from the first one,
+// along with the seq of the first returned visit.
+func getVisitsFrom(ctx context.Context, q querier, id int64, at time.Time) ([]model.WarehouseVisit, int, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT `+warehouseVisitColumns+`, seq
+		FROM warehouse_visits
+		WHERE cargo_unit_id = ? AND seq >= COALESCE((
+			SELECT MAX(seq)
+			FROM warehouse_visits
+			WHERE cargo_unit_id = ? AND (arrived_at IS NULL OR arrived_at <= ?)
+		), 0)
+		ORDER BY seq`,
+		id, id, at.UnixNano(),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var visits []model.WarehouseVisit
+	var first int
+	for rows.Next() {
+		var seq int
+		visit, err := scanWarehouseVisit(rows, &seq)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(visits) == 0 {
+			first = seq
+		}
+		visits = append(visits, visit)
+	}
+
+	return visits, first, rows.Err()
+}
+
+// reportColumns are the columns of cargo_units u left joined with warehouse_arrivals a scanned by scanReport
+const reportColumns = `u.id, u.version, u.last_seen_at, a.warehouse_id, a.message,
+	a.latitude, a.longitude, a.altitude, a.accuracy, a.heading, a.speed, a.recorded_at, a.received_at`
+
+// scanReport scans reportColumns into a report without its track and visits.
+func scanReport(row interface{ Scan(dest ...any) error }) (model.MetricsReport, error) {
+	var (
+		report      model.MetricsReport
+		lastSeenAt  sql.NullInt64
+		warehouseID sql.NullInt64
+		message     sql.NullString
+		arrival     locationRow
+	)
+	if err := row.Scan(append([]any{&report.ID, &report.Version, &lastSeenAt, &warehouseID, &message}, arrival.dest()...)...); err != nil {
+		return model.MetricsReport{}, err
+	}
+	report.LastSeenAt = unixNano(lastSeenAt)
+	if warehouseID.Valid {
+		report.UnitReachedWarehouse = model.UnitReachedWarehouse{
+			Location: arrival.location(),
+			Announcement: model.WarehouseAnnouncement{
+				CargoUnitId: report.ID,
+				WarehouseId: warehouseID.Int64,
+				Message:     message.String,
+			},
+		}
+	}
+	return report, nil
+}
+
+// getReports returns reports of the units selected by where clause, optionally followed by ORDER BY and LIMIT,
+// over cargo_units u left joined with warehouse_arrivals a. Every report is returned if where is empty.
+func getReports(ctx context.Context, q querier, where string, args []any) ([]model.MetricsReport, error) {
+	query := `
+		SELECT ` + reportColumns + `
+		FROM cargo_units u LEFT JOIN warehouse_arrivals a ON a.cargo_unit_id = u.id`
+	if where != "" {
+		query += " WHERE " + where
+	} else {
+		query += " ORDER BY u.id"
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []model.MetricsReport
+	index := make(map[int64]int)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		index[report.ID] = len(reports)
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return reports, nil
+	}
+
+	// locations and visits of the selected units only, every unit is selected if where is empty
+	var unitsWhere string
+	var unitsArgs []any
+	if where != "" {
+		placeholders := make([]string, 0, len(reports))
+		for _, report := range reports {
+			placeholders = append(placeholders, "?")
+			unitsArgs = append(unitsArgs, report.ID)
+		}
+		unitsWhere = " WHERE cargo_unit_id IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	locations, err := q.QueryContext(ctx, `
+		SELECT cargo_unit_id, latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at
+		FROM locations`+unitsWhere+`
+		ORDER BY cargo_unit_id, seq`,
+		unitsArgs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer locations.Close()
+
+	for locations.Next() {
+		var (
+			id  int64
+			row locationRow
+		)
+		if err := locations.Scan(append([]any{&id}, row.dest()...)...); err != nil {
+			return nil, err
+		}
+		i, exist := index[id]
+		if !exist {
+			continue
+		}
+		reports[i].MoveUnit.CargoUnitId = id
+		reports[i].MoveUnit.Location = append(reports[i].MoveUnit.Location, row.location())
+	}
+	if err := locations.Err(); err != nil {
+		return nil, err
+	}
+
+	visits, err := q.QueryContext(ctx, `
+		SELECT `+warehouseVisitColumns+`
+		FROM warehouse_visits`+unitsWhere+`
+		ORDER BY cargo_unit_id, seq`,
+		unitsArgs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer visits.Close()
+
+	for visits.Next() {
+		visit, err := scanWarehouseVisit(visits)
+		if err != nil {
+			return nil, err
+		}
+		i, exist := index[visit.UnitReachedWarehouse.Announcement.CargoUnitId]
+		if !exist {
+			continue
+		}
+		reports[i].WarehouseVisits = append(reports[i].WarehouseVisits, visit)
+	}
+
+	return reports, visits.Err()
+}
+
+// warehouseVisitColumns are the columns of warehouse_visits scanned by scanWarehouseVisit
+const warehouseVisitColumns = `cargo_unit_id, warehouse_id, message, arrived_at, departed_at,
+	latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at`
+
+// scanWarehouseVisit scans warehouseVisitColumns into a visit and the columns following them into dest.
+func scanWarehouseVisit(rows *sql.Rows, dest ...any) (model.WarehouseVisit, error) {
+	var (
+		visit      model.WarehouseVisit
+		arrivedAt  sql.NullInt64
+		departedAt sql.NullInt64
+		row        locationRow
+	)
+	announcement := &visit.UnitReachedWarehouse.Announcement
+	columns := append([]any{&announcement.CargoUnitId, &announcement.WarehouseId, &announcement.Message, &arrivedAt, &departedAt}, row.dest()...)
+	if err := rows.Scan(append(columns, dest...)...); err != nil {
+		return model.WarehouseVisit{}, err
+	}
+	visit.UnitReachedWarehouse.Location = row.location()
+	visit.ArrivedAt = unixNano(arrivedAt)
+	visit.DepartedAt = unixNano(departedAt)
+	return visit, nil
+}
+
+// locationRow scans columns latitude, longitude, altitude, accuracy, heading, speed, recorded_at and received_at
+// of a location, they are NULL in warehouse_arrivals left joined to a unit in transit
+type locationRow struct {
+	latitude, longitude                sql.NullFloat64
+	altitude, accuracy, heading, speed sql.NullFloat64
+	recordedAt, receivedAt             sql.NullInt64
+}
+
+func (l *locationRow) dest() []any {
+	return []any{&l.latitude, &l.longitude, &l.altitude, &l.accuracy, &l.heading, &l.speed, &l.recordedAt, &l.receivedAt}
+}
+
+func (l *locationRow) location() model.Location {
+	return model.Location{
+		Latitude:   l.latitude.Float64,
+		Longitude:  l.longitude.Float64,
+		Altitude:   optional(l.altitude),
+		Accuracy:   optional(l.accuracy),
+		Heading:    optional(l.heading),
+		Speed:      optional(l.speed),
+		RecordedAt: unixNano(l.recordedAt),
+		ReceivedAt: unixNano(l.receivedAt),
+	}
+}
+
+// locationArgs returns arguments of columns scanned by locationRow.
+func locationArgs(location model.Location) []any {
+	return []any{
+		location.Latitude, location.Longitude,
+		nullFloat(location.Altitude), nullFloat(location.Accuracy), nullFloat(location.Heading), nullFloat(location.Speed),
+		nullUnixNano(location.RecordedAt), nullUnixNano(location.ReceivedAt),
+	}
+}
+
+// nullFloat returns NULL for nil f.
+func nullFloat(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
+
+// optional returns nil for NULL f.
+func optional(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// nullUnixNano returns t as unix time in nanoseconds, NULL if t is zero.
+func nullUnixNano(t time.Time) sql.NullInt64 {
+	if t.IsZero() {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: t.UnixNano(), Valid: true}
+}
+
+// unixNano returns time of unix time in nanoseconds, zero time if it is NULL.
+func unixNano(nanos sql.NullInt64) time.Time {
+	if !nanos.Valid {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos.Int64)
+}
+
+// saveEvents inserts events into the log.
+func saveEvents(ctx context.Context, q querier, events []model.CargoUnitEvent) error {
+	for _, event := range events {
+		var (
+			location    = event.Location
+			warehouseID sql.NullInt64
+			message     sql.NullString
+		)
+		switch event.Type {
+		case model.CargoUnitEventReachedWarehouse:
+			location = event.UnitReachedWarehouse.Location
+			warehouseID = sql.NullInt64{Int64: event.UnitReachedWarehouse.Announcement.WarehouseId, Valid: true}
+			message = sql.NullString{String: event.UnitReachedWarehouse.Announcement.Message, Valid: true}
+		case model.CargoUnitEventDepartedWarehouse:
+			location = event.UnitDepartedWarehouse.Location
+			warehouseID = sql.NullInt64{Int64: event.UnitDepartedWarehouse.Announcement.WarehouseId, Valid: true}
+			message = sql.NullString{String: event.UnitDepartedWarehouse.Announcement.Message, Valid: true}
+		}
+		// the location is received along with the event
+		location.ReceivedAt = event.ReceivedAt
+		args := append([]any{event.CargoUnitId, event.Sequence, event.Type, event.OccurredAt.UnixNano(), warehouseID, message}, locationArgs(location)...)
+		if _, err := q.ExecContext(ctx, `
+			INSERT INTO cargo_unit_events (cargo_unit_id, sequence, type, occurred_at, warehouse_id, message,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			args...,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveHead writes the report the events were applied to, except for its track. stored is the head the events
+// were applied to, nil if there is none, and visitSeq is the seq of its first visit. Events replace the arrival,
+// end the stored visit and start new ones, so only those rows are written.
+func saveHead(ctx context.Context, q querier, stored *model.MetricsReport, visitSeq int, report model.MetricsReport) error {
+	lastSeenAt := nullUnixNano(report.LastSeenAt)
+
+	var old model.MetricsReport
+	if stored == nil {
+		if _, err := q.ExecContext(ctx,
+			"INSERT INTO cargo_units (id, version, last_seen_at) VALUES (?, ?, ?)",
+			report.ID, report.Version, lastSeenAt,
+		); err != nil {
+			return err
+		}
+	} else {
+		old = *stored
+		if _, err := q.ExecContext(ctx,
+			"UPDATE cargo_units SET version = ?, last_seen_at = ? WHERE id = ?",
+			report.Version, lastSeenAt, report.ID,
+		); err != nil {
+			return err
+		}
+	}
+
+	if report.ReachedWarehouse() && !report.UnitReachedWarehouse.Equal(old.UnitReachedWarehouse) {
+		arrival := report.UnitReachedWarehouse
+		if _, err := q.ExecContext(ctx, `
+			INSERT INTO warehouse_arrivals (cargo_unit_id, warehouse_id, message,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (cargo_unit_id) DO UPDATE SET
+				warehouse_id = excluded.warehouse_id,
+				message = excluded.message,
+				latitude = excluded.latitude,
+				longitude = excluded.longitude,
+				altitude = excluded.altitude,
+				accuracy = excluded.accuracy,
+				heading = excluded.heading,
+				speed = excluded.speed,
+				recorded_at = excluded.recorded_at,
+				received_at = excluded.received_at`,
+			append([]any{report.ID, arrival.Announcement.WarehouseId, arrival.Announcement.Message}, locationArgs(arrival.Location)...)...,
+		); err != nil {
+			return err
+		}
+	}
+
+	// visits are rewritten from the first one the events have changed, that is the stored visit the events end
+	// unless a late arrival is inserted before it
+	first := 0
+	for first < len(old.WarehouseVisits) && first < len(report.WarehouseVisits) && report.WarehouseVisits[first].Equal(old.WarehouseVisits[first]) {
+		first++
+	}
+	if first < len(old.WarehouseVisits) {
+		if _, err := q.ExecContext(ctx, "DELETE FROM warehouse_visits WHERE cargo_unit_id = ? AND seq >= ?", report.ID, visitSeq+first); err != nil {
+			return err
+		}
+	}
+	for i := first; i < len(report.WarehouseVisits); i++ {
+		visit := report.WarehouseVisits[i]
+		if _, err := q.ExecContext(ctx, `
+			INSERT INTO warehouse_visits (cargo_unit_id, seq, warehouse_id, message, arrived_at, departed_at,
+				latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			append([]any{
+				report.ID, visitSeq + i, visit.UnitReachedWarehouse.Announcement.WarehouseId, visit.UnitReachedWarehouse.Announcement.Message,
+				nullUnixNano(visit.ArrivedAt), nullUnixNano(visit.DepartedAt),
+			}, locationArgs(visit.UnitReachedWarehouse.Location)...)...,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertLocation inserts location into the track of the unit the way model.Apply does, after the last location
+// of the same or earlier time, shifting the seq of the later ones. Locations without time are appended.
+func insertLocation(ctx context.Context, q querier, id int64, location model.Location) error {
+	t := location.Time()
+
+	var seq int64
+	err := q.QueryRowContext(ctx, `
+		SELECT seq + 1
+		FROM locations
+		WHERE cargo_unit_id = ? AND (? OR COALESCE(recorded_at, received_at) IS NULL OR COALESCE(recorded_at, received_at) <= ?)
+		ORDER BY seq DESC
+		LIMIT 1`,
+		id, t.IsZero(), t.UnixNano(),
+	).Scan(&seq)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	// seq is negated first, as rows are updated one by one and the key must stay unique meanwhile
+	result, err := q.ExecContext(ctx, "UPDATE locations SET seq = -seq - 1 WHERE cargo_unit_id = ? AND seq >= ?", id, seq)
+	if err != nil {
+		return err
+	}
+	if shifted, err := result.RowsAffected(); err != nil {
+		return err
+	} else if shifted > 0 {
+		if _, err := q.ExecContext(ctx, "UPDATE locations SET seq = -seq WHERE cargo_unit_id = ? AND seq < 0", id); err != nil {
+			return err
+		}
+	}
+
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO locations (cargo_unit_id, seq, latitude, longitude, altitude, accuracy, heading, speed, recorded_at, received_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		append([]any{id, seq}, locationArgs(location)...)...,
+	)
+	return err
+}
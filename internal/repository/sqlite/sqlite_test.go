@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repository {
+		r, err := Open(context.Background(), filepath.Join(t.TempDir(), "logistics.db"))
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
+
+func TestReportConformance(t *testing.T) {
+	repositorytest.RunReport(t, func(t *testing.T) repositorytest.Aggregator {
+		r, err := Open(context.Background(), filepath.Join(t.TempDir(), "logistics.db"))
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
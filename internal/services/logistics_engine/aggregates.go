@@ -0,0 +1,261 @@
+package logistics_engine
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"slices"
+	"sync"
+)
+
+// aggregates keeps metrics report of the units up to date as their reports are written, so the report is not rebuilt
+// from every unit on each request. Units written before the service started are loaded once, by the first report.
+type aggregates struct {
+	mu     sync.Mutex
+	loaded bool
+	units  map[int64]unitAggregate
+	// delivered and inTransit list unit ids ordered by id
+	delivered []int64
+	inTransit []int64
+	// visits counts warehouse visits by warehouse
+	visits map[int64]int64
+	travel model.TravelStats
+	// speeds orders the units by max speed, the max speed of travel is the one of the fastest unit
+	speeds unitSpeeds
+	// events adds up versions of the units, the version of a unit is the number of its events
+	events int64
+}
+
+// unitAggregate is what the unit adds to the metrics report
+type unitAggregate struct {
+	version int64
+	reached bool
+	visited []int64
+	travel  model.TravelStats
+}
+
+func newUnitAggregate(report model.MetricsReport) unitAggregate {
+	return unitAggregate{
+		version: report.Version,
+		reached: report.ReachedWarehouse(),
+		visited: visitedWarehouses(report),
+		travel:  travelStats(report.MoveUnit.Location),
+	}
+}
+
+func newAggregates() *aggregates {
+	return &aggregates{
+		units:  make(map[int64]unitAggregate),
+		visits: make(map[int64]int64),
+		speeds: unitSpeeds{index: make(map[int64]int)},
+	}
+}
+
+// observe replaces what the unit adds to the report with its report written by events, reports older than the observed
+// one are ignored. Travel stats of the unit are updated from the segments events changed when the previous report
+// of the unit was observed, they are computed from the whole track otherwise. The report of the unit is not kept.
+func (a *aggregates) observe(report model.MetricsReport, events []model.CargoUnitEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	old, exist := a.units[report.ID]
+	if !exist || len(events) == 0 || old.version != events[0].Sequence-1 || report.Version != events[len(events)-1].Sequence {
+		a.replace(report.ID, newUnitAggregate(report))
+		return
+	}
+	a.replace(report.ID, unitAggregate{
+		version: report.Version,
+		reached: report.ReachedWarehouse(),
+		visited: visitedWarehouses(report),
+		travel:  advanceTravelStats(old.travel, report.MoveUnit.Location, events),
+	})
+}
+
+func (a *aggregates) replace(id int64, unit unitAggregate) {
+	old, exist := a.units[id]
+	if exist && old.version >= unit.version {
+		return
+	}
+	a.units[id] = unit
+	a.events += unit.version - old.version
+
+	switch {
+	case !exist && unit.reached:
+		a.delivered = insertSorted(a.delivered, id)
+	case !exist:
+		a.inTransit = insertSorted(a.inTransit, id)
+	case !old.reached && unit.reached:
+		a.inTransit = removeSorted(a.inTransit, id)
+		a.delivered = insertSorted(a.delivered, id)
+	}
+
+	for _, warehouseID := range old.visited {
+		if a.visits[warehouseID]--; a.visits[warehouseID] == 0 {
+			delete(a.visits, warehouseID)
+		}
+	}
+	for _, warehouseID := range unit.visited {
+		a.visits[warehouseID]++
+	}
+
+	a.travel.DistanceMeters += unit.travel.DistanceMeters - old.travel.DistanceMeters
+	a.travel.StraightLineMeters += unit.travel.StraightLineMeters - old.travel.StraightLineMeters
+	a.travel.TimedDistanceMeters += unit.travel.TimedDistanceMeters - old.travel.TimedDistanceMeters
+	a.travel.TimedSeconds += unit.travel.TimedSeconds - old.travel.TimedSeconds
+	// the fastest unit may slow down, as a late location splits its fastest segment
+	a.speeds.set(id, unit.travel.MaxSpeed)
+	a.travel.MaxSpeed = a.speeds.max()
+}
+
+// report returns metrics report of the units, it takes time proportional to the number of units, as the report
+// lists ids of delivered and in transit units, and sorts warehouses. The first report or size loads every unit
+// of provider, writes wait for it.
+func (a *aggregates) report(ctx context.Context, provider ReportProvider) (model.Report, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(ctx, provider); err != nil {
+		return model.Report{}, err
+	}
+
+	report := model.Report{
+		DeliveryUnitsTotalNumber:                      int64(len(a.units)),
+		WarehousesReceivedSuppliesList:                make([]int64, 0, len(a.visits)),
+		DeliveryUnitsReachedDestination:               slices.Clone(a.delivered),
+		InTransitUnits:                                slices.Clone(a.inTransit),
+		DeliveryUnitsEachWarehouseReceivedTotalNumber: make([]model.DeliveryUnitsWarehouseReceivedTotalNumber, 0, len(a.visits)),
+		TravelStats:                                   a.travel,
+	}
+	for warehouseID, visits := range a.visits {
+		report.DeliveryUnitsEachWarehouseReceivedTotalNumber = append(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, model.DeliveryUnitsWarehouseReceivedTotalNumber{
+			WarehouseId:         warehouseID,
+			DeliveryUnitsNumber: visits,
+		})
+	}
+	slices.SortFunc(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, func(a, b model.DeliveryUnitsWarehouseReceivedTotalNumber) int {
+		return cmp.Compare(a.WarehouseId, b.WarehouseId)
+	})
+	for _, total := range report.DeliveryUnitsEachWarehouseReceivedTotalNumber {
+		report.WarehousesReceivedSuppliesList = append(report.WarehousesReceivedSuppliesList, total.WarehouseId)
+	}
+	if report.DeliveryUnitsReachedDestination == nil {
+		report.DeliveryUnitsReachedDestination = []int64{}
+	}
+	if report.InTransitUnits == nil {
+		report.InTransitUnits = []int64{}
+	}
+
+	return report, nil
+}
+
+// size counts the units and their events, the first count loads every unit of provider unless a report has.
+func (a *aggregates) size(ctx context.Context, provider ReportProvider) (model.RepositorySize, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(ctx, provider); err != nil {
+		return model.RepositorySize{}, err
+	}
+
+	return model.RepositorySize{
+		CargoUnits:     int64(len(a.units)),
+		InTransitUnits: int64(len(a.inTransit)),
+		Events:         a.events,
+	}, nil
+}
+
+// load loads every unit of provider once, a.mu must be held.
+func (a *aggregates) load(ctx context.Context, provider ReportProvider) error {
+	if a.loaded {
+		return nil
+	}
+
+	reports, err := provider.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, report := range reports {
+		a.replace(report.ID, newUnitAggregate(report))
+	}
+	a.loaded = true
+	return nil
+}
+
+// unitSpeeds is a max-heap of the units by max speed, index locates the unit in speeds,
+// so the speed of a unit is changed in logarithmic time.
+type unitSpeeds struct {
+	speeds []unitSpeed
+	index  map[int64]int
+}
+
+type unitSpeed struct {
+	id    int64
+	speed float64
+}
+
+// set sets the max speed of the unit
+func (s *unitSpeeds) set(id int64, speed float64) {
+	if i, exist := s.index[id]; exist {
+		s.speeds[i].speed = speed
+		heap.Fix(s, i)
+		return
+	}
+	heap.Push(s, unitSpeed{id: id, speed: speed})
+}
+
+// max returns the max speed of the fastest unit, 0 if there are no units
+func (s *unitSpeeds) max() float64 {
+	if len(s.speeds) == 0 {
+		return 0
+	}
+	return s.speeds[0].speed
+}
+
+func (s *unitSpeeds) Len() int { return len(s.speeds) }
+
+func (s *unitSpeeds) Less(i, j int) bool { return s.speeds[i].speed > s.speeds[j].speed }
+
+func (s *unitSpeeds) Swap(i, j int) {
+	s.speeds[i], s.speeds[j] = s.speeds[j], s.speeds[i]
+	s.index[s.speeds[i].id] = i
+	s.index[s.speeds[j].id] = j
+}
+
+func (s *unitSpeeds) Push(x any) {
+	speed := x.(unitSpeed)
+	s.index[speed.id] = len(s.speeds)
+	s.speeds = append(s.speeds, speed)
+}
+
+func (s *unitSpeeds) Pop() any {
+	speed := s.speeds[len(s.speeds)-1]
+	s.speeds = s.speeds[:len(s.speeds)-1]
+	delete(s.index, speed.id)
+	return speed
+}
+
+// insertSorted inserts id into ids ordered by id, new units mostly have the greatest id so far
+func insertSorted(ids []int64, id int64) []int64 {
+	i, _ := slices.BinarySearch(ids, id)
+	return slices.Insert(ids, i, id)
+}
+
+// removeSorted removes id from ids ordered by id
+func removeSorted(ids []int64, id int64) []int64 {
+	if i, found := slices.BinarySearch(ids, id); found {
+		return slices.Delete(ids, i, i+1)
+	}
+	return ids
+}
+
+// visitedWarehouses returns warehouse of every visit of the unit, the same warehouse is returned once per visit.
+// Units that have not reached any warehouse have visited none.
+func visitedWarehouses(report model.MetricsReport) []int64 {
+	visits := report.Visits()
+	warehouses := make([]int64, 0, len(visits))
+	for _, visit := range visits {
+		warehouses = append(warehouses, visit.UnitReachedWarehouse.Announcement.WarehouseId)
+	}
+	return warehouses
+}
@@ -0,0 +1,431 @@
+package logistics_engine
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/broker"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/memory"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rebuildReport aggregates metrics report from every unit the way MetricsReport did before aggregates were kept,
+// aggregates are checked and benchmarked against it
+func rebuildReport(reports []model.MetricsReport) model.Report {
+	report := model.Report{
+		DeliveryUnitsTotalNumber:                      int64(len(reports)),
+		WarehousesReceivedSuppliesList:                []int64{},
+		DeliveryUnitsReachedDestination:               []int64{},
+		InTransitUnits:                                []int64{},
+		DeliveryUnitsEachWarehouseReceivedTotalNumber: []model.DeliveryUnitsWarehouseReceivedTotalNumber{},
+	}
+
+	visits := make(map[int64]int64)
+	for _, r := range reports {
+		if r.ReachedWarehouse() {
+			report.DeliveryUnitsReachedDestination = append(report.DeliveryUnitsReachedDestination, r.ID)
+		} else {
+			report.InTransitUnits = append(report.InTransitUnits, r.ID)
+		}
+		for _, warehouseID := range visitedWarehouses(r) {
+			visits[warehouseID]++
+		}
+		report.TravelStats = report.TravelStats.Add(travelStats(r.MoveUnit.Location))
+	}
+	slices.Sort(report.DeliveryUnitsReachedDestination)
+	slices.Sort(report.InTransitUnits)
+
+	for warehouseID, total := range visits {
+		report.WarehousesReceivedSuppliesList = append(report.WarehousesReceivedSuppliesList, warehouseID)
+		report.DeliveryUnitsEachWarehouseReceivedTotalNumber = append(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, model.DeliveryUnitsWarehouseReceivedTotalNumber{
+			WarehouseId:         warehouseID,
+			DeliveryUnitsNumber: total,
+		})
+	}
+	slices.Sort(report.WarehousesReceivedSuppliesList)
+	slices.SortFunc(report.DeliveryUnitsEachWarehouseReceivedTotalNumber, func(a, b model.DeliveryUnitsWarehouseReceivedTotalNumber) int {
+		return cmp.Compare(a.WarehouseId, b.WarehouseId)
+	})
+
+	return report
+}
+
+func assertReport(t *testing.T, got, want model.Report) {
+	t.Helper()
+
+	if got.DeliveryUnitsTotalNumber != want.DeliveryUnitsTotalNumber {
+		t.Fatalf("got %d units, want %d", got.DeliveryUnitsTotalNumber, want.DeliveryUnitsTotalNumber)
+	}
+	if !slices.Equal(got.WarehousesReceivedSuppliesList, want.WarehousesReceivedSuppliesList) {
+		t.Fatalf("got warehouses received supplies %v, want %v", got.WarehousesReceivedSuppliesList, want.WarehousesReceivedSuppliesList)
+	}
+	if !slices.Equal(got.DeliveryUnitsReachedDestination, want.DeliveryUnitsReachedDestination) {
+		t.Fatalf("got units reached destination %v, want %v", got.DeliveryUnitsReachedDestination, want.DeliveryUnitsReachedDestination)
+	}
+	if !slices.Equal(got.InTransitUnits, want.InTransitUnits) {
+		t.Fatalf("got units in transit %v, want %v", got.InTransitUnits, want.InTransitUnits)
+	}
+	if !slices.Equal(got.DeliveryUnitsEachWarehouseReceivedTotalNumber, want.DeliveryUnitsEachWarehouseReceivedTotalNumber) {
+		t.Fatalf("got warehouse totals %v, want %v", got.DeliveryUnitsEachWarehouseReceivedTotalNumber, want.DeliveryUnitsEachWarehouseReceivedTotalNumber)
+	}
+	for _, f := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"distance", got.TravelStats.DistanceMeters, want.TravelStats.DistanceMeters},
+		{"straight line", got.TravelStats.StraightLineMeters, want.TravelStats.StraightLineMeters},
+		{"timed distance", got.TravelStats.TimedDistanceMeters, want.TravelStats.TimedDistanceMeters},
+		{"timed seconds", got.TravelStats.TimedSeconds, want.TravelStats.TimedSeconds},
+		{"max speed", got.TravelStats.MaxSpeed, want.TravelStats.MaxSpeed},
+	} {
+		if math.Abs(f.got-f.want) > 1e-6*max(1, math.Abs(f.want)) {
+			t.Fatalf("got %s %v, want %v", f.name, f.got, f.want)
+		}
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	moved := func(id int64) model.MetricsReport {
+		return model.MetricsReport{ID: id, MoveUnit: model.MoveUnit{CargoUnitId: id, Location: []model.Location{{Latitude: 1}}}, Version: 1}
+	}
+	reached := func(id int64, warehouseIDs ...int64) model.MetricsReport {
+		report := moved(id)
+		for i, warehouseID := range warehouseIDs {
+			report.Apply(model.CargoUnitEvent{
+				Type:        model.CargoUnitEventReachedWarehouse,
+				CargoUnitId: id,
+				UnitReachedWarehouse: model.UnitReachedWarehouse{
+					Announcement: model.WarehouseAnnouncement{CargoUnitId: id, WarehouseId: warehouseID},
+				},
+				Sequence: int64(i + 2),
+			})
+		}
+		return report
+	}
+	// saved before warehouse visits were tracked, the last arrival is its only visit
+	legacy := moved(7)
+	legacy.UnitReachedWarehouse.Announcement = model.WarehouseAnnouncement{CargoUnitId: 7, WarehouseId: 30}
+
+	tests := []struct {
+		name       string
+		observed   []model.MetricsReport
+		warehouses []int64
+		delivered  []int64
+		inTransit  []int64
+		totals     []model.DeliveryUnitsWarehouseReceivedTotalNumber
+	}{
+		{
+			name:       "no units",
+			warehouses: []int64{},
+			delivered:  []int64{},
+			inTransit:  []int64{},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{},
+		},
+		{
+			name:       "units in transit only",
+			observed:   []model.MetricsReport{moved(2), moved(1)},
+			warehouses: []int64{},
+			delivered:  []int64{},
+			inTransit:  []int64{1, 2},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{},
+		},
+		{
+			name:       "delivered and in transit units",
+			observed:   []model.MetricsReport{reached(3, 20), moved(1), reached(2, 10)},
+			warehouses: []int64{10, 20},
+			delivered:  []int64{2, 3},
+			inTransit:  []int64{1},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{{WarehouseId: 10, DeliveryUnitsNumber: 1}, {WarehouseId: 20, DeliveryUnitsNumber: 1}},
+		},
+		{
+			name:       "every visit is counted",
+			observed:   []model.MetricsReport{reached(1, 10, 20, 10), reached(2, 10), legacy},
+			warehouses: []int64{10, 20, 30},
+			delivered:  []int64{1, 2, 7},
+			inTransit:  []int64{},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{{WarehouseId: 10, DeliveryUnitsNumber: 3}, {WarehouseId: 20, DeliveryUnitsNumber: 1}, {WarehouseId: 30, DeliveryUnitsNumber: 1}},
+		},
+		{
+			name:       "unit reaching warehouses replaces what it added",
+			observed:   []model.MetricsReport{moved(1), reached(1, 10), reached(1, 10, 20)},
+			warehouses: []int64{10, 20},
+			delivered:  []int64{1},
+			inTransit:  []int64{},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{{WarehouseId: 10, DeliveryUnitsNumber: 1}, {WarehouseId: 20, DeliveryUnitsNumber: 1}},
+		},
+		{
+			name:       "late observed versions are ignored",
+			observed:   []model.MetricsReport{reached(1, 10, 20), reached(1, 10), moved(1)},
+			warehouses: []int64{10, 20},
+			delivered:  []int64{1},
+			inTransit:  []int64{},
+			totals:     []model.DeliveryUnitsWarehouseReceivedTotalNumber{{WarehouseId: 10, DeliveryUnitsNumber: 1}, {WarehouseId: 20, DeliveryUnitsNumber: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAggregates()
+			for _, report := range tt.observed {
+				a.observe(report, nil)
+			}
+
+			got, err := a.report(context.Background(), memory.New())
+			if err != nil {
+				t.Fatalf("report() error = %v", err)
+			}
+			assertReport(t, got, model.Report{
+				DeliveryUnitsTotalNumber:                      int64(len(tt.delivered) + len(tt.inTransit)),
+				WarehousesReceivedSuppliesList:                tt.warehouses,
+				DeliveryUnitsReachedDestination:               tt.delivered,
+				InTransitUnits:                                tt.inTransit,
+				DeliveryUnitsEachWarehouseReceivedTotalNumber: tt.totals,
+			})
+		})
+	}
+}
+
+func TestAggregatesMaxSpeed(t *testing.T) {
+	a := newAggregates()
+	// steps change the max speed of a unit, versions grow as the unit is written
+	steps := []struct {
+		id    int64
+		speed float64
+		want  float64
+	}{
+		{id: 1, speed: 5, want: 5},
+		{id: 2, speed: 9, want: 9},
+		{id: 3, speed: 7, want: 9},
+		{id: 2, speed: 6, want: 7},
+		{id: 3, speed: 1, want: 6},
+		{id: 1, speed: 8, want: 8},
+		{id: 1, speed: 0, want: 6},
+		{id: 2, speed: 0, want: 1},
+	}
+	versions := make(map[int64]int64)
+	for i, step := range steps {
+		versions[step.id]++
+		a.replace(step.id, unitAggregate{version: versions[step.id], travel: model.TravelStats{MaxSpeed: step.speed}})
+		if a.travel.MaxSpeed != step.want {
+			t.Fatalf("got max speed %v after step %d, want %v", a.travel.MaxSpeed, i, step.want)
+		}
+	}
+}
+
+func TestAdvanceTravelStats(t *testing.T) {
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	rnd := rand.New(rand.NewSource(1))
+
+	var report model.MetricsReport
+	stats := travelStats(nil)
+	for sequence := int64(1); sequence <= 500; {
+		// writes move the unit to late locations, locations without time and reach warehouses, up to 3 events at once
+		events := make([]model.CargoUnitEvent, rnd.Intn(3)+1)
+		for i := range events {
+			location := model.Location{Latitude: rnd.Float64()*180 - 90, Longitude: rnd.Float64()*360 - 180}
+			if rnd.Intn(10) > 0 {
+				location.RecordedAt = at.Add(time.Duration(rnd.Intn(3600)) * time.Second)
+			}
+			events[i] = model.CargoUnitEvent{Type: model.CargoUnitEventMoved, CargoUnitId: 1, Location: location, Sequence: sequence}
+			if rnd.Intn(5) == 0 {
+				events[i] = model.CargoUnitEvent{Type: model.CargoUnitEventReachedWarehouse, CargoUnitId: 1, OccurredAt: at, Sequence: sequence}
+			}
+			report.Apply(events[i])
+			sequence++
+		}
+
+		stats = advanceTravelStats(stats, report.MoveUnit.Location, events)
+		want := travelStats(report.MoveUnit.Location)
+		for _, f := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"distance", stats.DistanceMeters, want.DistanceMeters},
+			{"straight line", stats.StraightLineMeters, want.StraightLineMeters},
+			{"timed distance", stats.TimedDistanceMeters, want.TimedDistanceMeters},
+			{"timed seconds", stats.TimedSeconds, want.TimedSeconds},
+			{"max speed", stats.MaxSpeed, want.MaxSpeed},
+		} {
+			if math.Abs(f.got-f.want) > 1e-6*max(1, math.Abs(f.want)) {
+				t.Fatalf("got %s %v at version %d, want %v", f.name, f.got, report.Version, f.want)
+			}
+		}
+	}
+}
+
+// TestAggregatesMatchRebuiltReport is meant to be run with -race.
+func TestAggregatesMatchRebuiltReport(t *testing.T) {
+	const (
+		trackers = 8
+		pings    = 100
+		units    = 20
+	)
+
+	ctx := context.Background()
+	repository := memory.New()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// trackers report late locations and reach warehouses, units written before the service started
+	// are loaded by the first report while the service writes
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	write := func(l *LogisticsEngine, seed int64) {
+		rnd := rand.New(rand.NewSource(seed))
+		for p := 0; p < pings; p++ {
+			id := rnd.Int63n(units) + 1
+			location := &logistics_v2.Location{
+				Latitude:   rnd.Float64()*180 - 90,
+				Longitude:  rnd.Float64()*360 - 180,
+				RecordedAt: timestamppb.New(at.Add(time.Duration(rnd.Intn(3600)) * time.Second)),
+			}
+			var err error
+			if rnd.Intn(5) == 0 {
+				_, err = l.UnitReachedWarehouseV2(ctx, &logistics_v2.UnitReachedWarehouseRequest{
+					Location:     location,
+					Announcement: &logistics_v2.WarehouseAnnouncement{CargoUnitId: id, WarehouseId: rnd.Int63n(5) + 1},
+				})
+			} else {
+				_, err = l.MoveUnitV2(ctx, &logistics_v2.MoveUnitRequest{CargoUnitId: id, Location: location})
+			}
+			if err != nil {
+				t.Errorf("write error = %v", err)
+				return
+			}
+		}
+	}
+
+	write(NewLogisticsEngine(log, repository, repository, broker.New(1)), 0)
+
+	l := NewLogisticsEngine(log, repository, repository, broker.New(1))
+	var wg sync.WaitGroup
+	for tr := 1; tr <= trackers; tr++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			write(l, int64(tr))
+		}()
+	}
+	if _, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{}); err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	wg.Wait()
+
+	got, err := l.aggregates.report(ctx, repository)
+	if err != nil {
+		t.Fatalf("report() error = %v", err)
+	}
+	reports, err := repository.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	assertReport(t, got, rebuildReport(reports))
+}
+
+func BenchmarkMetricsReport(b *testing.B) {
+	ctx := context.Background()
+
+	for _, units := range []int{1_000, 10_000, 100_000} {
+		repository := memory.New()
+		a := newAggregates()
+		at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+		for id := int64(1); id <= int64(units); id++ {
+			events := []model.CargoUnitEvent{
+				{Type: model.CargoUnitEventMoved, Location: model.Location{Latitude: 1, Longitude: 1, RecordedAt: at}},
+				{Type: model.CargoUnitEventMoved, Location: model.Location{Latitude: 2, Longitude: 2, RecordedAt: at.Add(time.Hour)}},
+			}
+			// every other unit has reached one of 100 warehouses
+			if id%2 == 0 {
+				events = append(events, model.CargoUnitEvent{
+					Type: model.CargoUnitEventReachedWarehouse,
+					UnitReachedWarehouse: model.UnitReachedWarehouse{
+						Announcement: model.WarehouseAnnouncement{CargoUnitId: id, WarehouseId: id%100 + 1},
+					},
+				})
+			}
+			report, saved, err := repository.AppendEvents(ctx, id, func(model.MetricsReport) ([]model.CargoUnitEvent, error) {
+				return events, nil
+			})
+			if err != nil {
+				b.Fatalf("AppendEvents() error = %v", err)
+			}
+			a.observe(report, saved)
+		}
+
+		b.Run(fmt.Sprintf("rebuild/units=%d", units), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				reports, err := repository.GetAll(ctx)
+				if err != nil {
+					b.Fatalf("GetAll() error = %v", err)
+				}
+				rebuildReport(reports)
+			}
+		})
+		b.Run(fmt.Sprintf("aggregates/units=%d", units), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := a.report(ctx, repository); err != nil {
+					b.Fatalf("report() error = %v", err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("service/units=%d", units), func(b *testing.B) {
+			l := NewLogisticsEngine(slog.New(slog.NewTextHandler(io.Discard, nil)), repository, repository, broker.New(1))
+			// the first report loads the units written before the service started
+			if _, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{}); err != nil {
+				b.Fatalf("MetricsReport() error = %v", err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{}); err != nil {
+					b.Fatalf("MetricsReport() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTravelStats measures travel stats of a unit with a long track after it moved, the stats are either computed
+// from the whole track or advanced from the segments the move changed
+func BenchmarkTravelStats(b *testing.B) {
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, locations := range []int{100, 10_000} {
+		var report model.MetricsReport
+		for i := 0; i < locations; i++ {
+			report.Apply(model.CargoUnitEvent{
+				Type:        model.CargoUnitEventMoved,
+				CargoUnitId: 1,
+				Location:    model.Location{Latitude: float64(i%90) / 2, Longitude: float64(i%180) / 2, RecordedAt: at.Add(time.Duration(i) * time.Second)},
+				Sequence:    int64(i + 1),
+			})
+		}
+		stats := travelStats(report.MoveUnit.Location)
+		events := []model.CargoUnitEvent{{
+			Type:        model.CargoUnitEventMoved,
+			CargoUnitId: 1,
+			Location:    model.Location{Latitude: 1, Longitude: 1, RecordedAt: at.Add(time.Duration(locations) * time.Second)},
+			Sequence:    int64(locations + 1),
+		}}
+		report.Apply(events[0])
+
+		b.Run(fmt.Sprintf("recompute/locations=%d", locations), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				travelStats(report.MoveUnit.Location)
+			}
+		})
+		b.Run(fmt.Sprintf("advance/locations=%d", locations), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				advanceTravelStats(stats, report.MoveUnit.Location, events)
+			}
+		})
+	}
+}
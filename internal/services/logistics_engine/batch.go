@@ -0,0 +1,201 @@
+package logistics_engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// batch groups events of the valid requests by cargo unit, so every unit is saved with a single repository call.
+type batch struct {
+	results []*logistics_v1.BatchItemResult
+	units   []int64
+	items   map[int64][]batchItem
+}
+
+type batchItem struct {
+	index int
+	event model.CargoUnitEvent
+	// version is the report version required by if_match, anyVersion if any version fits
+	version int64
+	err     error
+}
+
+func newBatch(size int) *batch {
+	return &batch{
+		results: make([]*logistics_v1.BatchItemResult, size),
+		items:   make(map[int64][]batchItem),
+	}
+}
+
+func (b *batch) add(index int, version int64, event model.CargoUnitEvent) {
+	if _, exist := b.items[event.CargoUnitId]; !exist {
+		b.units = append(b.units, event.CargoUnitId)
+	}
+	b.items[event.CargoUnitId] = append(b.items[event.CargoUnitId], batchItem{index: index, event: event, version: version})
+}
+
+func (b *batch) result(index int, id int64, err error) {
+	st := status.New(codes.OK, "")
+	if err != nil {
+		st = ErrorStatus(err)
+	}
+	b.results[index] = &logistics_v1.BatchItemResult{
+		Index:       int32(index),
+		CargoUnitId: id,
+		Status:      st.Proto(),
+	}
+}
+
+func (b *batch) response() *logistics_v1.BatchResponse {
+	resp := &logistics_v1.BatchResponse{Results: b.results}
+	for _, result := range b.results {
+		if codes.Code(result.GetStatus().GetCode()) == codes.OK {
+			resp.AcceptedNumber++
+		} else {
+			resp.RejectedNumber++
+		}
+	}
+	return resp
+}
+
+// itemError prefixes field violations of the request with its path in the batch
+func itemError(index int, err error) error {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	violations := make([]FieldViolation, 0, len(validationErr.Violations))
+	for _, v := range validationErr.Violations {
+		violations = append(violations, FieldViolation{
+			Field:       fmt.Sprintf("requests[%d].%s", index, v.Field),
+			Description: v.Description,
+		})
+	}
+	return &ValidationError{Violations: violations}
+}
+
+func (l *LogisticsEngine) BatchMoveUnits(ctx context.Context, in *logistics_v1.BatchMoveUnitsRequest) (*logistics_v1.BatchResponse, error) {
+	const opLabel = "LogisticsEngine.BatchMoveUnits"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("BatchSize", strconv.Itoa(len(in.GetRequests()))),
+	)
+
+	log.Info("attempting to save batch of move unit data")
+
+	// every request of the batch is received at once
+	receivedAt := time.Now()
+	b := newBatch(len(in.GetRequests()))
+	for i, req := range in.GetRequests() {
+		if err := Validate(req); err != nil {
+			b.result(i, req.GetCargoUnitId(), itemError(i, err))
+			continue
+		}
+		version, err := parseIfMatch(req.GetIfMatch())
+		if err != nil {
+			b.result(i, req.GetCargoUnitId(), itemError(i, err))
+			continue
+		}
+
+		location := fromLocation(req.GetLocation(), receivedAt)
+		b.add(i, version, model.CargoUnitEvent{
+			Type:        model.CargoUnitEventMoved,
+			CargoUnitId: req.GetCargoUnitId(),
+			Location:    location,
+			OccurredAt:  location.Time(),
+			ReceivedAt:  receivedAt,
+		})
+	}
+
+	l.saveBatch(ctx, log, b)
+
+	return b.response(), nil
+}
+
+func (l *LogisticsEngine) BatchUnitReachedWarehouse(ctx context.Context, in *logistics_v1.BatchUnitReachedWarehouseRequest) (*logistics_v1.BatchResponse, error) {
+	const opLabel = "LogisticsEngine.BatchUnitReachedWarehouse"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("BatchSize", strconv.Itoa(len(in.GetRequests()))),
+	)
+
+	log.Info("attempting to save batch of unit reached warehouse data")
+
+	// every request of the batch is received at once
+	receivedAt := time.Now()
+	b := newBatch(len(in.GetRequests()))
+	for i, req := range in.GetRequests() {
+		if err := Validate(req); err != nil {
+			b.result(i, req.GetAnnouncement().GetCargoUnitId(), itemError(i, err))
+			continue
+		}
+		version, err := parseIfMatch(req.GetIfMatch())
+		if err != nil {
+			b.result(i, req.GetAnnouncement().GetCargoUnitId(), itemError(i, err))
+			continue
+		}
+
+		location := fromLocation(req.GetLocation(), receivedAt)
+		b.add(i, version, model.CargoUnitEvent{
+			Type:        model.CargoUnitEventReachedWarehouse,
+			CargoUnitId: req.GetAnnouncement().GetCargoUnitId(),
+			UnitReachedWarehouse: model.UnitReachedWarehouse{
+				Location: location,
+				Announcement: model.WarehouseAnnouncement{
+					CargoUnitId: req.GetAnnouncement().GetCargoUnitId(),
+					WarehouseId: req.GetAnnouncement().GetWarehouseId(),
+					Message:     req.GetAnnouncement().GetMessage(),
+				},
+			},
+			OccurredAt: location.Time(),
+			ReceivedAt: receivedAt,
+		})
+	}
+
+	l.saveBatch(ctx, log, b)
+
+	return b.response(), nil
+}
+
+// saveBatch appends events of every unit in one atomic repository call
+func (l *LogisticsEngine) saveBatch(ctx context.Context, log *slog.Logger, b *batch) {
+	for _, id := range b.units {
+		items := b.items[id]
+
+		err := l.appendEvents(ctx, id, func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+			// if_match of every request refers to the version stored before the batch
+			var events []model.CargoUnitEvent
+			for i := range items {
+				items[i].err = nil
+				if err := checkVersion(items[i].version, report); err != nil {
+					items[i].err = err
+					continue
+				}
+				events = append(events, items[i].event)
+			}
+			return events, nil
+		})
+		if err != nil {
+			log.Error("failed to save metrics report", slog.String("CargoUnitId", strconv.FormatInt(id, 10)), logging.Err(err))
+			for i := range items {
+				items[i].err = err
+			}
+		}
+
+		for _, item := range items {
+			b.result(item.index, id, item.err)
+		}
+	}
+}
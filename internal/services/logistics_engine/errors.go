@@ -0,0 +1,133 @@
+package logistics_engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// errorDomain is the domain of google.rpc.ErrorInfo details
+const errorDomain = "logistics.api.v1"
+
+// retryDelay is the delay suggested to clients on retryable errors
+const retryDelay = time.Second
+
+// ErrInvalidArgument is returned when a request
+// can not be processed as it is
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrInvalidPageToken is returned when a page token
+// was not issued by ListCargoUnits or ListCargoUnitEvents
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// ErrSubscriberTooSlow is returned when a watcher
+// does not keep up with the events
+var ErrSubscriberTooSlow = errors.New("subscriber is too slow")
+
+// ErrNotAtWarehouse is returned when a unit departs
+// from a warehouse it is not staying at
+var ErrNotAtWarehouse = errors.New("cargo unit is not staying at the warehouse")
+
+// ErrShuttingDown is returned when a watch
+// is interrupted by the service shutdown
+var ErrShuttingDown = errors.New("service is shutting down")
+
+// FieldViolation describes a single invalid request field
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is returned when request fields are invalid, it matches ErrInvalidArgument
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	return "invalid argument: " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// ErrorStatus maps err to gRPC status carrying google.rpc error details.
+// Errors unknown to the domain are mapped to codes.Internal.
+func ErrorStatus(err error) *status.Status {
+	var validationErr *ValidationError
+
+	switch {
+	case errors.As(err, &validationErr):
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range validationErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return withDetails(status.New(codes.InvalidArgument, validationErr.Error()), badRequest)
+	case errors.Is(err, ErrInvalidPageToken):
+		return withDetails(status.New(codes.InvalidArgument, "invalid page token"), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "page_token", Description: "was not issued by the previous response"},
+			},
+		})
+	case errors.Is(err, ErrInvalidArgument):
+		return withDetails(status.New(codes.InvalidArgument, "invalid argument"), errorInfo("INVALID_ARGUMENT"))
+	case errors.Is(err, repository.ErrConflict):
+		return withDetails(status.New(codes.Aborted, "cargo unit was changed since etag was issued, read it again"), errorInfo("ETAG_MISMATCH"))
+	case errors.Is(err, repository.ErrAborted):
+		return withDetails(status.New(codes.Aborted, "cargo unit was changed concurrently, retry the request"), errorInfo("CONCURRENT_CHANGE"), retryInfo())
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return withDetails(status.New(codes.AlreadyExists, "cargo unit already exists"), errorInfo("CARGO_UNIT_ALREADY_EXISTS"))
+	case errors.Is(err, ErrNotAtWarehouse):
+		return withDetails(status.New(codes.FailedPrecondition, "cargo unit is not staying at the warehouse"), errorInfo("CARGO_UNIT_NOT_AT_WAREHOUSE"))
+	case errors.Is(err, repository.ErrNotFound):
+		return withDetails(status.New(codes.NotFound, "cargo unit not found"), errorInfo("CARGO_UNIT_NOT_FOUND"))
+	case errors.Is(err, repository.ErrUnavailable):
+		return withDetails(status.New(codes.Unavailable, "storage is unavailable, retry the request"), errorInfo("STORAGE_UNAVAILABLE"), retryInfo())
+	case errors.Is(err, ErrShuttingDown):
+		return withDetails(status.New(codes.Unavailable, "server is shutting down"), errorInfo("SHUTTING_DOWN"), retryInfo())
+	case errors.Is(err, ErrSubscriberTooSlow):
+		return withDetails(status.New(codes.ResourceExhausted, "watcher does not keep up with cargo unit events"), errorInfo("SUBSCRIBER_TOO_SLOW"))
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "request deadline exceeded")
+	}
+
+	return status.New(codes.Internal, "internal error")
+}
+
+func errorInfo(reason string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	}
+}
+
+func retryInfo() *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	}
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
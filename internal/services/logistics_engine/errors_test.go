@@ -0,0 +1,84 @@
+package logistics_engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+		// reason is the reason of ErrorInfo detail, fields are the fields of BadRequest detail
+		reason string
+		fields []string
+		retry  bool
+	}{
+		{
+			name:   "validation error",
+			err:    &ValidationError{Violations: []FieldViolation{{Field: "cargo_unit_id", Description: "value must be greater than 0"}, {Field: "location.Latitude", Description: "value must be less than or equal to 90"}}},
+			code:   codes.InvalidArgument,
+			fields: []string{"cargo_unit_id", "location.Latitude"},
+		},
+		{name: "invalid page token", err: ErrInvalidPageToken, code: codes.InvalidArgument, fields: []string{"page_token"}},
+		{name: "invalid argument", err: ErrInvalidArgument, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT"},
+		{name: "etag mismatch", err: repository.ErrConflict, code: codes.Aborted, reason: "ETAG_MISMATCH"},
+		{name: "concurrent change", err: repository.ErrAborted, code: codes.Aborted, reason: "CONCURRENT_CHANGE", retry: true},
+		{name: "already exists", err: repository.ErrAlreadyExists, code: codes.AlreadyExists, reason: "CARGO_UNIT_ALREADY_EXISTS"},
+		{name: "not at warehouse", err: ErrNotAtWarehouse, code: codes.FailedPrecondition, reason: "CARGO_UNIT_NOT_AT_WAREHOUSE"},
+		{name: "not found", err: repository.ErrNotFound, code: codes.NotFound, reason: "CARGO_UNIT_NOT_FOUND"},
+		{name: "storage unavailable", err: repository.ErrUnavailable, code: codes.Unavailable, reason: "STORAGE_UNAVAILABLE", retry: true},
+		{name: "shutting down", err: ErrShuttingDown, code: codes.Unavailable, reason: "SHUTTING_DOWN", retry: true},
+		{name: "subscriber too slow", err: ErrSubscriberTooSlow, code: codes.ResourceExhausted, reason: "SUBSCRIBER_TOO_SLOW"},
+		{name: "canceled", err: context.Canceled, code: codes.Canceled},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{name: "unknown error", err: errors.New("disk on fire"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// errors are mapped the same wrapped by the operation they failed
+			st := ErrorStatus(fmt.Errorf("LogisticsEngine.MoveUnit: %w", tt.err))
+			if st.Code() != tt.code {
+				t.Fatalf("got code %v, want %v", st.Code(), tt.code)
+			}
+
+			var reason string
+			var fields []string
+			var retry bool
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					if d.GetDomain() != errorDomain {
+						t.Fatalf("got domain %s, want %s", d.GetDomain(), errorDomain)
+					}
+					reason = d.GetReason()
+				case *errdetails.BadRequest:
+					for _, violation := range d.GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				case *errdetails.RetryInfo:
+					retry = d.GetRetryDelay().AsDuration() > 0
+				default:
+					t.Fatalf("got detail %T, want none", detail)
+				}
+			}
+			if reason != tt.reason {
+				t.Fatalf("got reason %q, want %q", reason, tt.reason)
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Fatalf("got field violations of %v, want %v", fields, tt.fields)
+			}
+			if retry != tt.retry {
+				t.Fatalf("got retry info %v, want %v", retry, tt.retry)
+			}
+		})
+	}
+}
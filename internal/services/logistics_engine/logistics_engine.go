@@ -2,222 +2,708 @@ package logistics_engine
 
 import (
 	"context"
-	"errors"
+	"encoding/base64"
+	"fmt"
 	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
 	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
 	"github.com/ivanbulyk/logistics_engine_api/internal/model"
 	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log/slog"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 )
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+	// defaultStatsWindow is the time window of warehouse stats requested without from
+	defaultStatsWindow = 24 * time.Hour
+)
+
+// LogisticsEngine expects requests validated against the rules declared in the proto, grpcserver validates them
+// before they reach it. Requests of a batch are validated one by one here, so every invalid one is reported in its result.
 type LogisticsEngine struct {
 	log          *slog.Logger
 	dlvUnitSaver DeliveryUnitSaver
 	rptProvider  ReportProvider
+	evtBroker    EventBroker
+	// aggregates is nil if rptProvider aggregates metrics report itself
+	aggregates *aggregates
+	metrics    *metrics
 }
 
-func NewLogisticsEngine(log *slog.Logger, dlvUnitSaver DeliveryUnitSaver, rptProvider ReportProvider) *LogisticsEngine {
-	return &LogisticsEngine{
+func NewLogisticsEngine(log *slog.Logger, dlvUnitSaver DeliveryUnitSaver, rptProvider ReportProvider, evtBroker EventBroker) *LogisticsEngine {
+	l := &LogisticsEngine{
 		log:          log,
 		dlvUnitSaver: dlvUnitSaver,
 		rptProvider:  rptProvider,
+		evtBroker:    evtBroker,
+		metrics:      newMetrics(),
 	}
+	// providers aggregating the report themselves may be written by other replicas too,
+	// the report is aggregated by the service only from the units it writes
+	if _, ok := rptProvider.(ReportAggregator); !ok {
+		l.aggregates = newAggregates()
+	}
+	return l
 }
 
 type DeliveryUnitSaver interface {
-	Create(_ context.Context, report model.MetricsReport) (model.MetricsReport, error)
-	Update(_ context.Context, report model.MetricsReport) error
 	GetByID(_ context.Context, id int64) (model.MetricsReport, error)
+	// AppendEvents saves events returned by decide to the log of the unit and applies them to its report atomically.
+	// decide is given the head of the report, see model.MetricsReport.Head. The returned report may be the head only
+	// if the saver aggregates metrics report itself, the service keeps aggregates of whole reports otherwise.
+	AppendEvents(_ context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) (model.MetricsReport, []model.CargoUnitEvent, error)
 }
 
 type ReportProvider interface {
 	GetAll(_ context.Context) ([]model.MetricsReport, error)
+	GetByID(_ context.Context, id int64) (model.MetricsReport, error)
+	List(_ context.Context, filter model.CargoUnitsFilter, afterID int64, limit int) ([]model.MetricsReport, error)
+	ListEvents(_ context.Context, id int64, afterSequence int64, limit int) ([]model.CargoUnitEvent, error)
+	ListWarehouseVisits(_ context.Context, filter model.WarehouseVisitsFilter) ([]model.WarehouseVisit, error)
+	Series(_ context.Context, filter model.SeriesFilter) ([]model.SeriesBucket, error)
+}
+
+// ReportAggregator is implemented by report providers able to aggregate metrics report without loading every report
+type ReportAggregator interface {
+	Report(_ context.Context) (model.Report, error)
+	Size(_ context.Context) (model.RepositorySize, error)
+}
+
+type EventBroker interface {
+	Publish(event model.CargoUnitEvent)
+	Subscribe(filter model.CargoUnitEventFilter) (<-chan model.CargoUnitEvent, func())
+	Done() <-chan struct{}
 }
 
 func (l *LogisticsEngine) MoveUnit(ctx context.Context, in *logistics_v1.MoveUnitRequest) (*logistics_v1.DefaultResponse, error) {
 	const opLabel = "LogisticsEngine.MoveUnit"
 
+	if _, err := l.MoveUnitV2(ctx, moveUnitRequestV2(in)); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v1.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) UnitReachedWarehouse(ctx context.Context, in *logistics_v1.UnitReachedWarehouseRequest) (*logistics_v1.DefaultResponse, error) {
+	const opLabel = "LogisticsEngine.UnitReachedWarehouse"
+
+	if _, err := l.UnitReachedWarehouseV2(ctx, unitReachedWarehouseRequestV2(in)); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v1.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) UnitDepartedWarehouse(ctx context.Context, in *logistics_v1.UnitDepartedWarehouseRequest) (*logistics_v1.DefaultResponse, error) {
+	const opLabel = "LogisticsEngine.UnitDepartedWarehouse"
+
+	if _, err := l.UnitDepartedWarehouseV2(ctx, unitDepartedWarehouseRequestV2(in)); err != nil {
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v1.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) GetCargoUnit(ctx context.Context, in *logistics_v1.GetCargoUnitRequest) (*logistics_v1.GetCargoUnitResponse, error) {
+	const opLabel = "LogisticsEngine.GetCargoUnit"
+
 	log := l.log.With(
 		slog.String("opLabel", opLabel),
 		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
 	)
 
-	report := model.MetricsReport{
-		ID: in.GetCargoUnitId(),
-		MoveUnit: model.MoveUnit{
-			CargoUnitId: in.GetCargoUnitId(),
-			Location: []model.Location{
-				{
-					Latitude:  in.GetLocation().GetLatitude(),
-					Longitude: in.GetLocation().GetLongitude(),
-				},
-			},
-		},
-		UnitReachedWarehouse: model.UnitReachedWarehouse{
-			Location: model.Location{
-				Latitude:  0,
-				Longitude: 0,
-			},
-			Announcement: model.WarehouseAnnouncement{
-				CargoUnitId: 0,
-				WarehouseId: 0,
-				Message:     "",
-			},
-		},
+	log.Info("attempting to get cargo unit")
+
+	report, err := l.rptProvider.GetByID(ctx, in.GetCargoUnitId())
+	if err != nil {
+		log.Error("failed to get cargo unit", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
 	}
 
-	log.Info("attempting to create and save metrics report with move unit data")
+	return &logistics_v1.GetCargoUnitResponse{
+		CargoUnit: toCargoUnit(report),
+	}, nil
+}
+
+func (l *LogisticsEngine) GetCargoUnitTrack(ctx context.Context, in *logistics_v1.GetCargoUnitTrackRequest) (*logistics_v1.GetCargoUnitTrackResponse, error) {
+	const opLabel = "LogisticsEngine.GetCargoUnitTrack"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
+	)
+
+	log.Info("attempting to get cargo unit track")
 
-	_, err := l.dlvUnitSaver.Create(ctx, report)
+	report, err := l.rptProvider.GetByID(ctx, in.GetCargoUnitId())
 	if err != nil {
-		if errors.Is(err, repository.ErrAlreadyExists) {
-			l.log.Warn("metrics report with the id already exists. updating move unit data", logging.Err(err))
-			report, _ = l.dlvUnitSaver.GetByID(ctx, in.GetCargoUnitId())
-			location := model.Location{
-				Latitude:  in.GetLocation().GetLatitude(),
-				Longitude: in.GetLocation().GetLongitude(),
-			}
-			report.MoveUnit.Location = append(report.MoveUnit.Location, location)
-			_ = l.dlvUnitSaver.Update(ctx, report)
+		log.Error("failed to get cargo unit track", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
 
-		}
+	track := make([]*logistics_v1.Location, 0, len(report.MoveUnit.Location))
+	for _, location := range report.MoveUnit.Location {
+		track = append(track, toLocation(location))
+	}
+
+	return &logistics_v1.GetCargoUnitTrackResponse{
+		CargoUnitId:      report.ID,
+		Track:            track,
+		LastLocation:     lastLocation(report),
+		WarehouseArrival: warehouseArrival(report),
+		Etag:             etag(report.Version),
+		WarehouseVisits:  warehouseVisits(report),
+	}, nil
+}
 
+func (l *LogisticsEngine) GetCargoUnitStats(ctx context.Context, in *logistics_v1.GetCargoUnitStatsRequest) (*logistics_v1.GetCargoUnitStatsResponse, error) {
+	const opLabel = "LogisticsEngine.GetCargoUnitStats"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
+	)
+
+	log.Info("attempting to get cargo unit stats")
+
+	report, err := l.rptProvider.GetByID(ctx, in.GetCargoUnitId())
+	if err != nil {
+		log.Error("failed to get cargo unit stats", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
 	}
 
-	return &logistics_v1.DefaultResponse{}, nil
+	return &logistics_v1.GetCargoUnitStatsResponse{
+		CargoUnitId: report.ID,
+		Stats:       toTravelStats(travelStats(report.MoveUnit.Location)),
+	}, nil
 }
 
-func (l *LogisticsEngine) UnitReachedWarehouse(ctx context.Context, in *logistics_v1.UnitReachedWarehouseRequest) (*logistics_v1.DefaultResponse, error) {
-	const opLabel = "LogisticsEngine.UnitReachedWarehouse"
+func (l *LogisticsEngine) ListCargoUnits(ctx context.Context, in *logistics_v1.ListCargoUnitsRequest) (*logistics_v1.ListCargoUnitsResponse, error) {
+	const opLabel = "LogisticsEngine.ListCargoUnits"
 
 	log := l.log.With(
 		slog.String("opLabel", opLabel),
-		slog.String("CargoUnitId", strconv.FormatInt(in.GetAnnouncement().GetCargoUnitId(), 10)),
 	)
-	report := model.MetricsReport{
-		ID: in.GetAnnouncement().GetCargoUnitId(),
-		MoveUnit: model.MoveUnit{
-			CargoUnitId: 0,
-			Location:    []model.Location{},
-		},
-		UnitReachedWarehouse: model.UnitReachedWarehouse{
-			Location: model.Location{
-				Latitude:  in.GetLocation().GetLatitude(),
-				Longitude: in.GetLocation().GetLongitude(),
-			},
-			Announcement: model.WarehouseAnnouncement{
-				CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
-				WarehouseId: in.GetAnnouncement().GetWarehouseId(),
-				Message:     in.GetAnnouncement().GetMessage(),
-			},
-		},
+
+	log.Info("attempting to list cargo units")
+
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
 	}
 
-	log.Info("attempting to create and save metrics report with unit reached warehouse data")
+	afterID := int64(math.MinInt64)
+	if in.GetPageToken() != "" {
+		id, err := decodePageToken(in.GetPageToken())
+		if err != nil {
+			log.Warn("failed to decode page token", logging.Err(err))
+			return nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+		afterID = id
+	}
 
-	_, err := l.dlvUnitSaver.Create(ctx, report)
+	filter := model.CargoUnitsFilter{
+		WarehouseId: in.GetWarehouseId(),
+	}
+	switch in.GetStatus() {
+	case logistics_v1.CargoUnitStatus_CARGO_UNIT_STATUS_IN_TRANSIT:
+		filter.Status = model.CargoUnitStatusInTransit
+	case logistics_v1.CargoUnitStatus_CARGO_UNIT_STATUS_ARRIVED:
+		filter.Status = model.CargoUnitStatusArrived
+	}
+	if in.GetLastSeenAfter() != nil {
+		filter.LastSeenAfter = in.GetLastSeenAfter().AsTime()
+	}
+
+	// one extra report tells whether there is a next page
+	reports, err := l.rptProvider.List(ctx, filter, afterID, pageSize+1)
 	if err != nil {
-		if errors.Is(err, repository.ErrAlreadyExists) {
-			l.log.Warn("metrics report with the id already exists. updating unit reached warehouse data", logging.Err(err))
-			report, _ = l.dlvUnitSaver.GetByID(ctx, in.GetAnnouncement().GetCargoUnitId())
-
-			ur := model.UnitReachedWarehouse{
-				Location: model.Location{
-					Latitude:  in.GetLocation().GetLatitude(),
-					Longitude: in.GetLocation().GetLongitude(),
-				},
-				Announcement: model.WarehouseAnnouncement{
-					CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
-					WarehouseId: in.GetAnnouncement().GetWarehouseId(),
-					Message:     in.GetAnnouncement().GetMessage(),
-				},
-			}
-			report.UnitReachedWarehouse = ur
-			_ = l.dlvUnitSaver.Update(ctx, report)
+		log.Error("failed to list cargo units", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	resp := &logistics_v1.ListCargoUnitsResponse{}
+	if len(reports) > pageSize {
+		reports = reports[:pageSize]
+		resp.NextPageToken = encodePageToken(reports[pageSize-1].ID)
+	}
+	resp.CargoUnits = make([]*logistics_v1.CargoUnit, 0, len(reports))
+	for _, report := range reports {
+		resp.CargoUnits = append(resp.CargoUnits, toCargoUnit(report))
+	}
+
+	return resp, nil
+}
+
+func (l *LogisticsEngine) ListCargoUnitEvents(ctx context.Context, in *logistics_v1.ListCargoUnitEventsRequest) (*logistics_v1.ListCargoUnitEventsResponse, error) {
+	const opLabel = "LogisticsEngine.ListCargoUnitEvents"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
+	)
+
+	log.Info("attempting to list cargo unit events")
+
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
 
+	var afterSequence int64
+	if in.GetPageToken() != "" {
+		sequence, err := decodePageToken(in.GetPageToken())
+		if err != nil {
+			log.Warn("failed to decode page token", logging.Err(err))
+			return nil, fmt.Errorf("%s: %w", opLabel, err)
 		}
+		afterSequence = sequence
+	}
 
+	// one extra event tells whether there is a next page
+	events, err := l.rptProvider.ListEvents(ctx, in.GetCargoUnitId(), afterSequence, pageSize+1)
+	if err != nil {
+		log.Error("failed to list cargo unit events", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
 	}
 
-	return &logistics_v1.DefaultResponse{}, nil
+	resp := &logistics_v1.ListCargoUnitEventsResponse{}
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		resp.NextPageToken = encodePageToken(events[pageSize-1].Sequence)
+	}
+	resp.Events = make([]*logistics_v1.CargoUnitEvent, 0, len(events))
+	for _, event := range events {
+		resp.Events = append(resp.Events, toCargoUnitEvent(event))
+	}
+
+	return resp, nil
+}
+
+// WatchCargoUnits calls send for every event matching the request until ctx is done or send fails.
+func (l *LogisticsEngine) WatchCargoUnits(ctx context.Context, in *logistics_v1.WatchCargoUnitsRequest, send func(*logistics_v1.CargoUnitEvent) error) error {
+	const opLabel = "LogisticsEngine.WatchCargoUnits"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+	)
+
+	log.Info("attempting to watch cargo units")
+
+	events, unsubscribe := l.evtBroker.Subscribe(model.CargoUnitEventFilter{
+		CargoUnitIds: in.GetCargoUnitIds(),
+		WarehouseId:  in.GetWarehouseId(),
+	})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("watcher left")
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				select {
+				case <-l.evtBroker.Done():
+					log.Info("watch interrupted by shutdown")
+					return fmt.Errorf("%s: %w", opLabel, ErrShuttingDown)
+				default:
+				}
+				log.Warn("watcher dropped behind events")
+				return fmt.Errorf("%s: %w", opLabel, ErrSubscriberTooSlow)
+			}
+			if err := send(toCargoUnitEvent(event)); err != nil {
+				log.Error("failed to send cargo unit event", logging.Err(err))
+				return fmt.Errorf("%s: %w", opLabel, err)
+			}
+		}
+	}
 }
 
-func (l *LogisticsEngine) MetricsReport(ctx context.Context, in *logistics_v1.DefaultRequest) (*logistics_v1.MetricsReportResponse, error) {
+func (l *LogisticsEngine) MetricsReport(ctx context.Context, in *logistics_v1.MetricsReportRequest) (*logistics_v1.MetricsReportResponse, error) {
 	const opLabel = "LogisticsEngine.MetricsReport"
 
 	log := l.log.With(
 		slog.String("opLabel", opLabel),
 	)
 
+	filter, err := seriesFilter(in)
+	if err != nil {
+		log.Warn("invalid metrics report request", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
 	log.Info("attempting to get metrics report")
 
-	report, err := l.rptProvider.GetAll(ctx)
+	timer := prometheus.NewTimer(l.metrics.reportDuration)
+	defer timer.ObserveDuration()
+
+	var report model.Report
+	if aggregator, ok := l.rptProvider.(ReportAggregator); ok {
+		report, err = aggregator.Report(ctx)
+		if err != nil {
+			log.Error("failed to aggregate metrics report", logging.Err(err))
+			return nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+	} else {
+		report, err = l.aggregates.report(ctx, l.rptProvider)
+		if err != nil {
+			log.Error("failed to get metrics report", logging.Err(err))
+			return nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+	}
+
+	// the series takes every event of the window, the report without one stays as cheap as the aggregates
+	var buckets []model.SeriesBucket
+	if filter.GroupBy != model.SeriesGroupByNone {
+		buckets, err = l.rptProvider.Series(ctx, filter)
+		if err != nil {
+			log.Error("failed to get metrics report series", logging.Err(err))
+			return nil, fmt.Errorf("%s: %w", opLabel, err)
+		}
+	}
+
+	resp := toMetricsReportResponse(report)
+	resp.Series = make([]*logistics_v1.MetricsBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		resp.Series = append(resp.Series, toMetricsBucket(bucket))
+	}
+
+	return resp, nil
+}
+
+func (l *LogisticsEngine) WarehouseStats(ctx context.Context, in *logistics_v1.WarehouseStatsRequest) (*logistics_v1.WarehouseStatsResponse, error) {
+	const opLabel = "LogisticsEngine.WarehouseStats"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("WarehouseId", strconv.FormatInt(in.GetWarehouseId(), 10)),
+	)
+
+	to := time.Now()
+	if in.GetTo() != nil {
+		to = in.GetTo().AsTime()
+	}
+	from := to.Add(-defaultStatsWindow)
+	if in.GetFrom() != nil {
+		from = in.GetFrom().AsTime()
+	}
+	if !from.Before(to) {
+		err := &ValidationError{Violations: []FieldViolation{{Field: "to", Description: "must be after from"}}}
+		log.Warn("invalid warehouse stats request", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	log.Info("attempting to get warehouse stats")
+
+	// visits ended before the window do not count in it
+	visits, err := l.rptProvider.ListWarehouseVisits(ctx, model.WarehouseVisitsFilter{
+		WarehouseId:       in.GetWarehouseId(),
+		NotDepartedBefore: from,
+	})
 	if err != nil {
-		log.Error("failed to get metrics report", logging.Err(err))
-		return nil, err
+		log.Error("failed to list warehouse visits", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	stats := warehouseStats(visits, in.GetWarehouseId(), from, to)
+	resp := &logistics_v1.WarehouseStatsResponse{
+		From:       timestamppb.New(from),
+		To:         timestamppb.New(to),
+		Warehouses: make([]*logistics_v1.WarehouseStats, 0, len(stats)),
+	}
+	for _, s := range stats {
+		resp.Warehouses = append(resp.Warehouses, toWarehouseStats(s, to.Sub(from)))
+	}
+
+	return resp, nil
+}
+
+// seriesFilter returns filter of the series requested, the time window must not be empty
+func seriesFilter(in *logistics_v1.MetricsReportRequest) (model.SeriesFilter, error) {
+	filter := model.SeriesFilter{
+		WarehouseIds: in.GetWarehouseIds(),
+	}
+	switch in.GetGroupBy() {
+	case logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_HOUR:
+		filter.GroupBy = model.SeriesGroupByHour
+	case logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_DAY:
+		filter.GroupBy = model.SeriesGroupByDay
+	case logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_WAREHOUSE:
+		filter.GroupBy = model.SeriesGroupByWarehouse
+	}
+	if in.GetFrom() != nil {
+		filter.From = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		filter.To = in.GetTo().AsTime()
 	}
 
-	mr := &logistics_v1.MetricsReportResponse{
-		DeliveryUnitsNumber:                           int64(len(report)),
-		WarehousesReceivedSuppliesList:                warehousesReceivedSuppliesList(report),
-		DeliveryUnitsReachedDestination:               deliveryUnitsReachedDestination(report),
-		DeliveryUnitsEachWarehouseReceivedTotalNumber: deliveryUnitsEachWarehouseReceivedTotalNumber(report),
+	var violations []FieldViolation
+	if filter.GroupBy == model.SeriesGroupByNone {
+		// the filters apply to the series only, they are not ignored silently
+		if in.GetFrom() != nil {
+			violations = append(violations, FieldViolation{Field: "from", Description: "requires group_by"})
+		}
+		if in.GetTo() != nil {
+			violations = append(violations, FieldViolation{Field: "to", Description: "requires group_by"})
+		}
+		if len(in.GetWarehouseIds()) > 0 {
+			violations = append(violations, FieldViolation{Field: "warehouse_ids", Description: "requires group_by"})
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		violations = append(violations, FieldViolation{Field: "to", Description: "must be after from"})
 	}
+	if len(violations) > 0 {
+		return model.SeriesFilter{}, &ValidationError{Violations: violations}
+	}
+	return filter, nil
+}
 
-	return mr, nil
+func toMetricsBucket(bucket model.SeriesBucket) *logistics_v1.MetricsBucket {
+	b := &logistics_v1.MetricsBucket{
+		WarehouseId: bucket.WarehouseId,
+		Arrivals:    bucket.Arrivals,
+		ActiveUnits: bucket.ActiveUnits,
+	}
+	if !bucket.Start.IsZero() {
+		b.Start = timestamppb.New(bucket.Start)
+	}
+	return b
 }
 
-// warehousesReceivedSuppliesList returns a list of warehouses that have received supplies
-func warehousesReceivedSuppliesList(report []model.MetricsReport) []int64 {
+func toMetricsReportResponse(report model.Report) *logistics_v1.MetricsReportResponse {
+	totals := make([]*logistics_v1.DeliveryUnitsWarehouseReceivedTotalNumber, 0, len(report.DeliveryUnitsEachWarehouseReceivedTotalNumber))
+	for _, total := range report.DeliveryUnitsEachWarehouseReceivedTotalNumber {
+		totals = append(totals, &logistics_v1.DeliveryUnitsWarehouseReceivedTotalNumber{
+			WarehouseId:         total.WarehouseId,
+			DeliveryUnitsNumber: total.DeliveryUnitsNumber,
+		})
+	}
+	return &logistics_v1.MetricsReportResponse{
+		DeliveryUnitsNumber:                           report.DeliveryUnitsTotalNumber,
+		WarehousesReceivedSuppliesList:                report.WarehousesReceivedSuppliesList,
+		DeliveryUnitsReachedDestination:               report.DeliveryUnitsReachedDestination,
+		DeliveryUnitsReachedDestinationNumber:         int64(len(report.DeliveryUnitsReachedDestination)),
+		InTransitUnits:                                report.InTransitUnits,
+		InTransitUnitsNumber:                          int64(len(report.InTransitUnits)),
+		DeliveryUnitsEachWarehouseReceivedTotalNumber: totals,
+		TravelStats:                                   toTravelStats(report.TravelStats),
+	}
+}
 
-	data := make(map[int64][]int64)
+// appendEvents saves events decided for the unit, keeps aggregates up to date with its report and publishes the saved events
+func (l *LogisticsEngine) appendEvents(ctx context.Context, id int64, decide func(report model.MetricsReport) ([]model.CargoUnitEvent, error)) error {
+	report, events, err := l.dlvUnitSaver.AppendEvents(ctx, id, decide)
+	if err != nil {
+		return err
+	}
 
-	list := []int64{}
-	for _, r := range report {
-		key := r.UnitReachedWarehouse.Announcement.WarehouseId
-		value := r.UnitReachedWarehouse.Announcement.CargoUnitId
-		if v, exist := data[key]; exist {
-			data[key] = append(v, value)
-		} else {
-			data[key] = []int64{value}
+	if l.aggregates != nil {
+		l.aggregates.observe(report, events)
+	}
+	l.metrics.observe(events)
+	for _, event := range events {
+		l.evtBroker.Publish(event)
+	}
+	return nil
+}
+
+// decideIfMatch appends event if the report has the version required by if_match
+func decideIfMatch(version int64, event model.CargoUnitEvent) func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+	return func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		if err := checkVersion(version, report); err != nil {
+			return nil, err
+		}
+		return []model.CargoUnitEvent{event}, nil
+	}
+}
+
+// checkVersion fails with repository.ErrConflict unless the report has the version required by if_match,
+// or with repository.ErrAlreadyExists if the unit is required not to exist yet. Any version fits anyVersion.
+func checkVersion(version int64, report model.MetricsReport) error {
+	switch {
+	case version == anyVersion || version == report.Version:
+		return nil
+	case version == 0:
+		return repository.ErrAlreadyExists
+	}
+	return repository.ErrConflict
+}
+
+// decideDeparture appends departure event if the unit is staying at the warehouse it departs from,
+// and the report has the version required by if_match
+func decideDeparture(version int64, event model.CargoUnitEvent) func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+	decide := decideIfMatch(version, event)
+	return func(report model.MetricsReport) ([]model.CargoUnitEvent, error) {
+		events, err := decide(report)
+		if err != nil {
+			return nil, err
+		}
+		if !report.StayingAt(event.UnitDepartedWarehouse.Announcement.WarehouseId) {
+			return nil, ErrNotAtWarehouse
+		}
+		return events, nil
+	}
+}
+
+// etag returns etag of the report version
+func etag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// anyVersion is the version parsed from empty if_match, the request is applied to any version of the unit
+const anyVersion = -1
+
+// parseIfMatch returns report version of the etag, or anyVersion if ifMatch is empty.
+// Etag "0" is the version of a unit that does not exist yet, so the request only creates the unit.
+func parseIfMatch(ifMatch string) (int64, error) {
+	if ifMatch == "" {
+		return anyVersion, nil
+	}
+	// etag may come quoted the way it is sent in HTTP ETag header
+	version, err := strconv.ParseInt(strings.Trim(ifMatch, `"`), 10, 64)
+	if err != nil || version < 0 {
+		return 0, &ValidationError{
+			Violations: []FieldViolation{{Field: "if_match", Description: "is not an etag issued by the server"}},
 		}
+	}
+	return version, nil
+}
+
+// encodePageToken returns page token pointing past the report with id, or past the event with the sequence
+func encodePageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// decodePageToken returns id of the last report of the previous page
+func decodePageToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	return id, nil
+}
 
+func toCargoUnit(report model.MetricsReport) *logistics_v1.CargoUnit {
+	cargoUnit := &logistics_v1.CargoUnit{
+		CargoUnitId:           report.ID,
+		LastLocation:          lastLocation(report),
+		LocationsNumber:       int64(len(report.MoveUnit.Location)),
+		WarehouseArrival:      warehouseArrival(report),
+		Etag:                  etag(report.Version),
+		WarehouseVisitsNumber: int64(len(report.Visits())),
 	}
-	for key := range data {
-		list = append(list, key)
+	if !report.LastSeenAt.IsZero() {
+		cargoUnit.LastSeenAt = timestamppb.New(report.LastSeenAt)
 	}
-	return list
+	return cargoUnit
 }
 
-// deliveryUnitsReachedDestination returns a list units that have reached their destination
-func deliveryUnitsReachedDestination(report []model.MetricsReport) []int64 {
-	list := []int64{}
-	for _, r := range report {
-		list = append(list, r.UnitReachedWarehouse.Announcement.CargoUnitId)
+// lastLocation returns the last location unit has been reported at, falling back to the warehouse location
+func lastLocation(report model.MetricsReport) *logistics_v1.Location {
+	if n := len(report.MoveUnit.Location); n > 0 {
+		return toLocation(report.MoveUnit.Location[n-1])
 	}
-	return list
+	if report.ReachedWarehouse() {
+		return toLocation(report.UnitReachedWarehouse.Location)
+	}
+	return nil
 }
 
-// deliveryUnitsEachWarehouseReceivedTotalNumber
-func deliveryUnitsEachWarehouseReceivedTotalNumber(report []model.MetricsReport) []*logistics_v1.DeliveryUnitsWarehouseReceivedTotalNumber {
-	data := make(map[int64][]int64)
+// warehouseArrival returns warehouse arrival of the unit or nil if unit has not reached warehouse yet
+func warehouseArrival(report model.MetricsReport) *logistics_v1.WarehouseArrival {
+	if !report.ReachedWarehouse() {
+		return nil
+	}
+	return toWarehouseArrival(report.UnitReachedWarehouse)
+}
 
-	list := []*logistics_v1.DeliveryUnitsWarehouseReceivedTotalNumber{}
-	for _, r := range report {
-		key := r.UnitReachedWarehouse.Announcement.WarehouseId
-		value := r.UnitReachedWarehouse.Announcement.CargoUnitId
-		if v, exist := data[key]; exist {
-			data[key] = append(v, value)
-		} else {
-			data[key] = []int64{value}
+// warehouseVisits returns every warehouse visit of the unit in the order of arrival
+func warehouseVisits(report model.MetricsReport) []*logistics_v1.WarehouseVisit {
+	visits := make([]*logistics_v1.WarehouseVisit, 0, len(report.Visits()))
+	for _, visit := range report.Visits() {
+		v := &logistics_v1.WarehouseVisit{
+			Arrival: toWarehouseArrival(visit.UnitReachedWarehouse),
 		}
+		if !visit.ArrivedAt.IsZero() {
+			v.ArrivedAt = timestamppb.New(visit.ArrivedAt)
+		}
+		if !visit.DepartedAt.IsZero() {
+			v.DepartedAt = timestamppb.New(visit.DepartedAt)
+		}
+		visits = append(visits, v)
+	}
+	return visits
+}
+
+func toWarehouseArrival(arrival model.UnitReachedWarehouse) *logistics_v1.WarehouseArrival {
+	return &logistics_v1.WarehouseArrival{
+		Location: toLocation(arrival.Location),
+		Announcement: &logistics_v1.WarehouseAnnouncement{
+			CargoUnitId: arrival.Announcement.CargoUnitId,
+			WarehouseId: arrival.Announcement.WarehouseId,
+			Message:     arrival.Announcement.Message,
+		},
 	}
-	for k, v := range data {
-		unit := &logistics_v1.DeliveryUnitsWarehouseReceivedTotalNumber{}
-		unit.WarehouseId = k
-		unit.DeliveryUnitsNumber = int64(len(v))
-		list = append(list, unit)
+}
+
+func toCargoUnitEvent(event model.CargoUnitEvent) *logistics_v1.CargoUnitEvent {
+	e := &logistics_v1.CargoUnitEvent{
+		CargoUnitId: event.CargoUnitId,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+		Sequence:    event.Sequence,
 	}
-	return list
+	if !event.ReceivedAt.IsZero() {
+		e.ReceivedAt = timestamppb.New(event.ReceivedAt)
+	}
+	switch event.Type {
+	case model.CargoUnitEventMoved:
+		e.Event = &logistics_v1.CargoUnitEvent_UnitMoved{
+			UnitMoved: &logistics_v1.UnitMoved{Location: toLocation(event.Location)},
+		}
+	case model.CargoUnitEventReachedWarehouse:
+		e.Event = &logistics_v1.CargoUnitEvent_UnitReachedWarehouse{
+			UnitReachedWarehouse: toWarehouseArrival(event.UnitReachedWarehouse),
+		}
+	case model.CargoUnitEventDepartedWarehouse:
+		e.Event = &logistics_v1.CargoUnitEvent_UnitDepartedWarehouse{
+			UnitDepartedWarehouse: &logistics_v1.WarehouseDeparture{
+				Location: toLocation(event.UnitDepartedWarehouse.Location),
+				Announcement: &logistics_v1.WarehouseAnnouncement{
+					CargoUnitId: event.UnitDepartedWarehouse.Announcement.CargoUnitId,
+					WarehouseId: event.UnitDepartedWarehouse.Announcement.WarehouseId,
+					Message:     event.UnitDepartedWarehouse.Announcement.Message,
+				},
+			},
+		}
+	}
+	return e
+}
+
+func toLocation(location model.Location) *logistics_v1.Location {
+	return locationV1(toLocationV2(location))
+}
+
+// fromLocation converts the requested location received at receivedAt, received_at of the request is ignored
+func fromLocation(location *logistics_v1.Location, receivedAt time.Time) model.Location {
+	return fromLocationV2(locationV2(location), receivedAt)
 }
@@ -0,0 +1,1009 @@
+package logistics_engine
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivanbulyk/logistics_engine_api/internal/broker"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository"
+	"github.com/ivanbulyk/logistics_engine_api/internal/repository/memory"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestLogisticsEngine() *LogisticsEngine {
+	repository := memory.New()
+	return NewLogisticsEngine(slog.New(slog.NewTextHandler(io.Discard, nil)), repository, repository, broker.New(1))
+}
+
+// TestMoveUnitConcurrentNoLostLocations is meant to be run with -race.
+func TestMoveUnitConcurrentNoLostLocations(t *testing.T) {
+	const (
+		trackers = 20
+		pings    = 50
+	)
+
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	var wg sync.WaitGroup
+	for tr := 0; tr < trackers; tr++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := 0; p < pings; p++ {
+				_, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{
+					CargoUnitId: 1,
+					Location:    &logistics_v1.Location{Latitude: 1, Longitude: 1},
+				})
+				if err != nil {
+					t.Errorf("MoveUnit() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	if got, want := len(track.GetTrack()), trackers*pings; got != want {
+		t.Fatalf("got %d locations, want %d", got, want)
+	}
+}
+
+func TestGetCargoUnit(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// unit 1 moves twice and reaches warehouse 5, unit 2 moves once
+	for _, latitude := range []uint32{1, 2} {
+		if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: latitude}}); err != nil {
+			t.Fatalf("MoveUnit() error = %v", err)
+		}
+	}
+	_, err := l.UnitReachedWarehouse(ctx, &logistics_v1.UnitReachedWarehouseRequest{
+		Location:     &logistics_v1.Location{Latitude: 3},
+		Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: "arrived"},
+	})
+	if err != nil {
+		t.Fatalf("UnitReachedWarehouse() error = %v", err)
+	}
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 2, Location: &logistics_v1.Location{Latitude: 4}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+
+	tests := []struct {
+		id        int64
+		latitudes []uint32
+		warehouse int64
+		etag      string
+	}{
+		{id: 1, latitudes: []uint32{1, 2}, warehouse: 5, etag: "3"},
+		{id: 2, latitudes: []uint32{4}, etag: "1"},
+	}
+	for _, tt := range tests {
+		unit, err := l.GetCargoUnit(ctx, &logistics_v1.GetCargoUnitRequest{CargoUnitId: tt.id})
+		if err != nil {
+			t.Fatalf("GetCargoUnit() error = %v", err)
+		}
+		got := unit.GetCargoUnit()
+		if got.GetCargoUnitId() != tt.id || got.GetLocationsNumber() != int64(len(tt.latitudes)) ||
+			got.GetLastLocation().GetLatitude() != tt.latitudes[len(tt.latitudes)-1] || got.GetEtag() != tt.etag {
+			t.Fatalf("got unit %v, want unit %d with %d locations, last at latitude %d and etag %s",
+				got, tt.id, len(tt.latitudes), tt.latitudes[len(tt.latitudes)-1], tt.etag)
+		}
+		if got.GetWarehouseArrival().GetAnnouncement().GetWarehouseId() != tt.warehouse {
+			t.Fatalf("got warehouse arrival %v, want warehouse %d", got.GetWarehouseArrival(), tt.warehouse)
+		}
+
+		track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: tt.id})
+		if err != nil {
+			t.Fatalf("GetCargoUnitTrack() error = %v", err)
+		}
+		var latitudes []uint32
+		for _, location := range track.GetTrack() {
+			latitudes = append(latitudes, location.GetLatitude())
+		}
+		if !slices.Equal(latitudes, tt.latitudes) || track.GetLastLocation().GetLatitude() != tt.latitudes[len(tt.latitudes)-1] || track.GetEtag() != tt.etag {
+			t.Fatalf("got track %v, want latitudes %v and etag %s", track, tt.latitudes, tt.etag)
+		}
+		if track.GetWarehouseArrival().GetAnnouncement().GetWarehouseId() != tt.warehouse {
+			t.Fatalf("got warehouse arrival %v, want warehouse %d", track.GetWarehouseArrival(), tt.warehouse)
+		}
+	}
+
+	// the unit reached warehouse at the location of the arrival
+	track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	if got := track.GetWarehouseArrival().GetLocation().GetLatitude(); got != 3 {
+		t.Fatalf("got warehouse arrival at latitude %d, want 3", got)
+	}
+
+	// unknown unit is not found
+	_, err = l.GetCargoUnit(ctx, &logistics_v1.GetCargoUnitRequest{CargoUnitId: 3})
+	if code := ErrorStatus(err).Code(); !errors.Is(err, repository.ErrNotFound) || code != codes.NotFound {
+		t.Fatalf("GetCargoUnit() error = %v with code %v, want %v", err, code, codes.NotFound)
+	}
+	_, err = l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 3})
+	if code := ErrorStatus(err).Code(); !errors.Is(err, repository.ErrNotFound) || code != codes.NotFound {
+		t.Fatalf("GetCargoUnitTrack() error = %v with code %v, want %v", err, code, codes.NotFound)
+	}
+}
+
+func TestMetricsReportCountsEveryVisit(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// unit 1 visits warehouse 5 twice, unit 2 is in transit
+	for _, warehouseID := range []int64{5, 6, 5} {
+		_, err := l.UnitReachedWarehouse(ctx, &logistics_v1.UnitReachedWarehouseRequest{
+			Location:     &logistics_v1.Location{Latitude: 1, Longitude: 1},
+			Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: warehouseID, Message: "arrived"},
+		})
+		if err != nil {
+			t.Fatalf("UnitReachedWarehouse() error = %v", err)
+		}
+	}
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 2, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+
+	report, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	got := make(map[int64]int64)
+	for _, total := range report.GetDeliveryUnitsEachWarehouseReceivedTotalNumber() {
+		got[total.GetWarehouseId()] = total.GetDeliveryUnitsNumber()
+	}
+	if want := map[int64]int64{5: 2, 6: 1}; !maps.Equal(got, want) {
+		t.Fatalf("got totals %v, want %v", got, want)
+	}
+	if got, want := report.GetWarehousesReceivedSuppliesList(), []int64{5, 6}; !slices.Equal(got, want) {
+		t.Fatalf("got warehouses received supplies %v, want %v", got, want)
+	}
+	if got := report.GetDeliveryUnitsReachedDestination(); !slices.Equal(got, []int64{1}) || report.GetDeliveryUnitsReachedDestinationNumber() != 1 {
+		t.Fatalf("got units reached destination %v, want [1]", got)
+	}
+	if got := report.GetInTransitUnits(); !slices.Equal(got, []int64{2}) || report.GetInTransitUnitsNumber() != 1 {
+		t.Fatalf("got units in transit %v, want [2]", got)
+	}
+
+	track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	visits := track.GetWarehouseVisits()
+	if len(visits) != 3 || visits[0].GetDepartedAt() == nil || visits[2].GetDepartedAt() != nil {
+		t.Fatalf("got warehouse visits %v, want 3 visits with the last one open", visits)
+	}
+}
+
+func TestMoveUnitOrdersTrackByRecordedAt(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// the second location was recorded first and delivered late, the last one was not timed by the device
+	recordedAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	locations := []*logistics_v1.Location{
+		{Latitude: 1, RecordedAt: timestamppb.New(recordedAt.Add(time.Minute))},
+		{Latitude: 2, RecordedAt: timestamppb.New(recordedAt)},
+		{Latitude: 3},
+	}
+	for _, location := range locations {
+		if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: location}); err != nil {
+			t.Fatalf("MoveUnit() error = %v", err)
+		}
+	}
+
+	track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	var got []uint32
+	for _, location := range track.GetTrack() {
+		if location.GetReceivedAt() == nil {
+			t.Fatalf("got location %v without received_at", location)
+		}
+		got = append(got, location.GetLatitude())
+	}
+	if want := []uint32{2, 1, 3}; !slices.Equal(got, want) {
+		t.Fatalf("got track latitudes %v, want %v", got, want)
+	}
+	if got := track.GetTrack()[0].GetRecordedAt().AsTime(); !got.Equal(recordedAt) {
+		t.Fatalf("got recorded at %v, want %v", got, recordedAt)
+	}
+}
+
+func TestMoveUnitV2ServedToV1(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	heading := 271.5
+	location := &logistics_v2.Location{Latitude: -33.8688, Longitude: 151.2093, Heading: &heading}
+	if _, err := l.MoveUnitV2(ctx, &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: location}); err != nil {
+		t.Fatalf("MoveUnitV2() error = %v", err)
+	}
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 10, Longitude: 20}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+
+	trackV2, err := l.GetCargoUnitTrackV2(ctx, &logistics_v2.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrackV2() error = %v", err)
+	}
+	if got := trackV2.GetTrack(); len(got) != 2 ||
+		got[0].GetLatitude() != -33.8688 || got[0].GetLongitude() != 151.2093 || got[0].GetHeading() != heading || got[0].Speed != nil ||
+		got[1].GetLatitude() != 10 || got[1].GetLongitude() != 20 {
+		t.Fatalf("got v2 track %v", got)
+	}
+
+	// v1 rounds to whole degrees and clamps the southern hemisphere to 0
+	trackV1, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	if got := trackV1.GetTrack(); len(got) != 2 || got[0].GetLatitude() != 0 || got[0].GetLongitude() != 151 || got[1].GetLatitude() != 10 {
+		t.Fatalf("got v1 track %v", got)
+	}
+}
+
+func TestTravelStats(t *testing.T) {
+	// a degree of longitude along the equator
+	const degree = earthRadiusMeters * math.Pi / 180
+
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		track []model.Location
+		want  model.TravelStats
+	}{
+		{name: "empty track", track: nil, want: model.TravelStats{}},
+		{name: "single location", track: []model.Location{{Longitude: 1, RecordedAt: at}}, want: model.TravelStats{}},
+		{
+			name: "timed segments",
+			track: []model.Location{
+				{Longitude: 0, RecordedAt: at},
+				{Longitude: 1, RecordedAt: at.Add(100 * time.Second)},
+				{Longitude: 3, ReceivedAt: at.Add(200 * time.Second)},
+			},
+			want: model.TravelStats{DistanceMeters: 3 * degree, StraightLineMeters: 3 * degree, TimedDistanceMeters: 3 * degree, TimedSeconds: 200, MaxSpeed: 2 * degree / 100},
+		},
+		{
+			name: "untimed and simultaneous locations count in distance only",
+			track: []model.Location{
+				{Longitude: 0, RecordedAt: at},
+				{Longitude: 2, RecordedAt: at},
+				{Longitude: 1},
+			},
+			want: model.TravelStats{DistanceMeters: 3 * degree, StraightLineMeters: 1 * degree},
+		},
+		{
+			name:  "back where it started",
+			track: []model.Location{{Latitude: -10, Longitude: 170}, {Latitude: -10, Longitude: -170}, {Latitude: -10, Longitude: 170}},
+			want:  model.TravelStats{DistanceMeters: 2 * haversine(model.Location{Latitude: -10, Longitude: 170}, model.Location{Latitude: -10, Longitude: -170})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := travelStats(tt.track)
+			for _, f := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"distance", got.DistanceMeters, tt.want.DistanceMeters},
+				{"straight line", got.StraightLineMeters, tt.want.StraightLineMeters},
+				{"timed distance", got.TimedDistanceMeters, tt.want.TimedDistanceMeters},
+				{"timed seconds", got.TimedSeconds, tt.want.TimedSeconds},
+				{"max speed", got.MaxSpeed, tt.want.MaxSpeed},
+			} {
+				if math.Abs(f.got-f.want) > 1e-6 {
+					t.Fatalf("got %s %v, want %v", f.name, f.got, f.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCargoUnitStatsAddedUpInMetricsReport(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// the shortest way across the antimeridian is 20 degrees long, not 340
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	for i, longitude := range []float64{170, -170} {
+		location := &logistics_v2.Location{Longitude: longitude, RecordedAt: timestamppb.New(at.Add(time.Duration(i) * time.Hour))}
+		if _, err := l.MoveUnitV2(ctx, &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: location}); err != nil {
+			t.Fatalf("MoveUnitV2() error = %v", err)
+		}
+	}
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 2, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+
+	want := 20 * earthRadiusMeters * math.Pi / 180
+	stats, err := l.GetCargoUnitStats(ctx, &logistics_v1.GetCargoUnitStatsRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitStats() error = %v", err)
+	}
+	if got := stats.GetStats(); math.Abs(got.GetDistanceMeters()-want) > 1e-6 || math.Abs(got.GetPathRatio()-1) > 1e-9 ||
+		math.Abs(got.GetAverageSpeed()-want/3600) > 1e-9 || got.GetMaxSpeed() != got.GetAverageSpeed() {
+		t.Fatalf("got stats %v, want %v meters in an hour", got, want)
+	}
+
+	report, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	if got := report.GetTravelStats(); !proto.Equal(got, stats.GetStats()) {
+		t.Fatalf("got travel stats %v, want %v of the only unit that moved", got, stats.GetStats())
+	}
+}
+
+func TestMetricsReportSeries(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// units 1 and 2 move in the first hour, unit 1 reaches warehouse 5 in the second one
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	for _, id := range []int64{1, 2} {
+		location := &logistics_v2.Location{Latitude: 1, RecordedAt: timestamppb.New(at.Add(10 * time.Minute))}
+		if _, err := l.MoveUnitV2(ctx, &logistics_v2.MoveUnitRequest{CargoUnitId: id, Location: location}); err != nil {
+			t.Fatalf("MoveUnitV2() error = %v", err)
+		}
+	}
+	_, err := l.UnitReachedWarehouseV2(ctx, &logistics_v2.UnitReachedWarehouseRequest{
+		Location:     &logistics_v2.Location{Latitude: 2, RecordedAt: timestamppb.New(at.Add(70 * time.Minute))},
+		Announcement: &logistics_v2.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: "arrived"},
+	})
+	if err != nil {
+		t.Fatalf("UnitReachedWarehouseV2() error = %v", err)
+	}
+
+	// the series starts with the second hour, totals are not limited to it
+	report, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{
+		From:    timestamppb.New(at.Add(time.Hour)),
+		GroupBy: logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_HOUR,
+	})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	want := []*logistics_v1.MetricsBucket{{Start: timestamppb.New(at.Add(time.Hour)), Arrivals: 1, ActiveUnits: 1}}
+	if got := report.GetSeries(); !slices.EqualFunc(got, want, func(a, b *logistics_v1.MetricsBucket) bool { return proto.Equal(a, b) }) {
+		t.Fatalf("got series %v, want %v", got, want)
+	}
+	if got := report.GetDeliveryUnitsNumber(); got != 2 {
+		t.Fatalf("got %d delivery units, want 2", got)
+	}
+
+	report, err = l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{GroupBy: logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_WAREHOUSE})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	want = []*logistics_v1.MetricsBucket{{WarehouseId: 5, Arrivals: 1, ActiveUnits: 1}}
+	if got := report.GetSeries(); !slices.EqualFunc(got, want, func(a, b *logistics_v1.MetricsBucket) bool { return proto.Equal(a, b) }) {
+		t.Fatalf("got series %v, want %v", got, want)
+	}
+
+	// an empty time window is rejected, and so are the filters of the series without group_by
+	tests := []struct {
+		name   string
+		in     *logistics_v1.MetricsReportRequest
+		fields []string
+	}{
+		{
+			name:   "empty window",
+			in:     &logistics_v1.MetricsReportRequest{From: timestamppb.New(at), To: timestamppb.New(at), GroupBy: logistics_v1.MetricsGroupBy_METRICS_GROUP_BY_DAY},
+			fields: []string{"to"},
+		},
+		{
+			name:   "filters without group_by",
+			in:     &logistics_v1.MetricsReportRequest{From: timestamppb.New(at), WarehouseIds: []int64{5}},
+			fields: []string{"from", "warehouse_ids"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := l.MetricsReport(ctx, tt.in)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("MetricsReport() error = %v, want %T", err, validationErr)
+			}
+			var fields []string
+			for _, v := range validationErr.Violations {
+				fields = append(fields, v.Field)
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Fatalf("got violations of %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+
+	// no series is taken without group_by
+	report, err = l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	if len(report.GetSeries()) != 0 || report.GetDeliveryUnitsNumber() != 2 {
+		t.Fatalf("got series %v and %d delivery units, want no series and 2 units", report.GetSeries(), report.GetDeliveryUnitsNumber())
+	}
+}
+
+func TestWarehouseStats(t *testing.T) {
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	from, to := at, at.Add(2*time.Hour)
+	stay := func(warehouseID int64, arrivedAt, departedAt time.Duration) model.WarehouseVisit {
+		visit := model.WarehouseVisit{
+			UnitReachedWarehouse: model.UnitReachedWarehouse{Announcement: model.WarehouseAnnouncement{WarehouseId: warehouseID}},
+			ArrivedAt:            at.Add(arrivedAt),
+		}
+		if departedAt != 0 {
+			visit.DepartedAt = at.Add(departedAt)
+		}
+		return visit
+	}
+
+	// ten units stay at warehouse 5 for 1 to 10 minutes
+	var stays []model.WarehouseVisit
+	for i := 1; i <= 10; i++ {
+		stays = append(stays, stay(5, 0, time.Duration(i)*time.Minute))
+	}
+
+	tests := []struct {
+		name        string
+		visits      []model.WarehouseVisit
+		warehouseID int64
+		want        []model.WarehouseStats
+	}{
+		{name: "no visits", visits: nil, want: []model.WarehouseStats{}},
+		{name: "requested warehouse without visits", visits: stays, warehouseID: 6, want: []model.WarehouseStats{{WarehouseId: 6}}},
+		{
+			name:   "dwell percentiles",
+			visits: stays,
+			want: []model.WarehouseStats{
+				{WarehouseId: 5, DwellP50: 5 * time.Minute, DwellP90: 9 * time.Minute, DwellP99: 10 * time.Minute, Arrivals: 10, Departures: 10},
+			},
+		},
+		{
+			name: "window",
+			visits: []model.WarehouseVisit{
+				// arrived before the window, departed in it
+				stay(5, -time.Hour, 30*time.Minute),
+				// arrived in the window, staying at its end
+				stay(5, time.Hour, 3*time.Hour),
+				stay(6, time.Hour, 0),
+				// arrived after the window
+				stay(6, 3*time.Hour, 0),
+				// staying since before the window
+				stay(7, -time.Hour, 0),
+			},
+			want: []model.WarehouseStats{
+				{WarehouseId: 5, DwellP50: 90 * time.Minute, DwellP90: 90 * time.Minute, DwellP99: 90 * time.Minute, Arrivals: 1, Departures: 1, Occupancy: 1},
+				{WarehouseId: 6, Arrivals: 1, Occupancy: 1},
+				{WarehouseId: 7, Occupancy: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := warehouseStats(tt.visits, tt.warehouseID, from, to); !slices.Equal(got, tt.want) {
+				t.Fatalf("got stats %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitDepartedWarehouse(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	at := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	depart := func(warehouseID int64, after time.Duration) error {
+		_, err := l.UnitDepartedWarehouseV2(ctx, &logistics_v2.UnitDepartedWarehouseRequest{
+			Location:     &logistics_v2.Location{Latitude: 1, RecordedAt: timestamppb.New(at.Add(after))},
+			Announcement: &logistics_v2.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: warehouseID, Message: "departed"},
+		})
+		return err
+	}
+
+	if err := depart(5, 0); !errors.Is(err, ErrNotAtWarehouse) {
+		t.Fatalf("UnitDepartedWarehouseV2() error = %v, want %v before the unit arrived", err, ErrNotAtWarehouse)
+	}
+	_, err := l.UnitReachedWarehouseV2(ctx, &logistics_v2.UnitReachedWarehouseRequest{
+		Location:     &logistics_v2.Location{Latitude: 1, RecordedAt: timestamppb.New(at)},
+		Announcement: &logistics_v2.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: "arrived"},
+	})
+	if err != nil {
+		t.Fatalf("UnitReachedWarehouseV2() error = %v", err)
+	}
+	if err := depart(6, 10*time.Minute); !errors.Is(err, ErrNotAtWarehouse) {
+		t.Fatalf("UnitDepartedWarehouseV2() error = %v, want %v from another warehouse", err, ErrNotAtWarehouse)
+	}
+	if err := depart(5, 20*time.Minute); err != nil {
+		t.Fatalf("UnitDepartedWarehouseV2() error = %v", err)
+	}
+	if err := depart(5, 30*time.Minute); !errors.Is(err, ErrNotAtWarehouse) {
+		t.Fatalf("UnitDepartedWarehouseV2() error = %v, want %v after the unit departed", err, ErrNotAtWarehouse)
+	}
+
+	stats, err := l.WarehouseStats(ctx, &logistics_v1.WarehouseStatsRequest{From: timestamppb.New(at), To: timestamppb.New(at.Add(time.Hour))})
+	if err != nil {
+		t.Fatalf("WarehouseStats() error = %v", err)
+	}
+	want := []*logistics_v1.WarehouseStats{{
+		WarehouseId:     5,
+		DwellP50:        durationpb.New(20 * time.Minute),
+		DwellP90:        durationpb.New(20 * time.Minute),
+		DwellP99:        durationpb.New(20 * time.Minute),
+		InboundUnits:    1,
+		OutboundUnits:   1,
+		InboundPerHour:  1,
+		OutboundPerHour: 1,
+	}}
+	if got := stats.GetWarehouses(); !slices.EqualFunc(got, want, func(a, b *logistics_v1.WarehouseStats) bool { return proto.Equal(a, b) }) {
+		t.Fatalf("got warehouse stats %v, want %v", got, want)
+	}
+
+	events, err := l.ListCargoUnitEvents(ctx, &logistics_v1.ListCargoUnitEventsRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("ListCargoUnitEvents() error = %v", err)
+	}
+	if got := events.GetEvents(); len(got) != 2 || got[1].GetUnitDepartedWarehouse().GetAnnouncement().GetWarehouseId() != 5 {
+		t.Fatalf("got events %v, want the arrival and the departure", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(l)
+
+	// unit 1 moves twice and reaches warehouse 5, unit 2 is in transit
+	for _, unitID := range []int64{1, 1, 2} {
+		if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: unitID, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+			t.Fatalf("MoveUnit() error = %v", err)
+		}
+	}
+	_, err := l.UnitReachedWarehouse(ctx, &logistics_v1.UnitReachedWarehouseRequest{
+		Location:     &logistics_v1.Location{Latitude: 1, Longitude: 1},
+		Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: "arrived"},
+	})
+	if err != nil {
+		t.Fatalf("UnitReachedWarehouse() error = %v", err)
+	}
+	if _, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{}); err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	got := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			name := family.GetName()
+			for _, label := range metric.GetLabel() {
+				name += "{" + label.GetName() + "=" + label.GetValue() + "}"
+			}
+			got[name] = metric.GetGauge().GetValue() + metric.GetCounter().GetValue() + float64(metric.GetHistogram().GetSampleCount())
+		}
+	}
+	want := map[string]float64{
+		"logistics_cargo_units":                              2,
+		"logistics_cargo_units_in_transit":                   1,
+		"logistics_repository_events":                        4,
+		"logistics_location_updates_total":                   3,
+		"logistics_warehouse_arrivals_total{warehouse_id=5}": 1,
+		"logistics_metrics_report_duration_seconds":          1,
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("got metrics %v, want %v", got, want)
+	}
+}
+
+func TestListCargoUnitsPages(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	// units 1 to 5 are in transit but unit 4, which reached warehouse 5
+	for id := int64(5); id > 0; id-- {
+		if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: id, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+			t.Fatalf("MoveUnit() error = %v", err)
+		}
+	}
+	_, err := l.UnitReachedWarehouse(ctx, &logistics_v1.UnitReachedWarehouseRequest{
+		Location:     &logistics_v1.Location{Latitude: 1},
+		Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 4, WarehouseId: 5, Message: "arrived"},
+	})
+	if err != nil {
+		t.Fatalf("UnitReachedWarehouse() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		status logistics_v1.CargoUnitStatus
+		want   []int64
+	}{
+		{name: "every unit", want: []int64{1, 2, 3, 4, 5}},
+		{name: "in transit", status: logistics_v1.CargoUnitStatus_CARGO_UNIT_STATUS_IN_TRANSIT, want: []int64{1, 2, 3, 5}},
+		{name: "arrived", status: logistics_v1.CargoUnitStatus_CARGO_UNIT_STATUS_ARRIVED, want: []int64{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			var pageToken string
+			for pages := 1; ; pages++ {
+				resp, err := l.ListCargoUnits(ctx, &logistics_v1.ListCargoUnitsRequest{PageSize: 2, PageToken: pageToken, Status: tt.status})
+				if err != nil {
+					t.Fatalf("ListCargoUnits() error = %v", err)
+				}
+				if len(resp.GetCargoUnits()) > 2 {
+					t.Fatalf("got %d units on page %d, want at most 2", len(resp.GetCargoUnits()), pages)
+				}
+				for _, unit := range resp.GetCargoUnits() {
+					got = append(got, unit.GetCargoUnitId())
+				}
+				if pageToken = resp.GetNextPageToken(); pageToken == "" {
+					break
+				}
+				if pages > len(tt.want) {
+					t.Fatalf("got more than %d pages", len(tt.want))
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got units %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListCargoUnitsInvalidPageToken(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	for _, token := range []string{"not base64!", base64.RawURLEncoding.EncodeToString([]byte("unit 1"))} {
+		_, err := l.ListCargoUnits(ctx, &logistics_v1.ListCargoUnitsRequest{PageToken: token})
+		if !errors.Is(err, ErrInvalidPageToken) {
+			t.Fatalf("ListCargoUnits() error = %v, want %v for page token %q", err, ErrInvalidPageToken, token)
+		}
+		if code := ErrorStatus(err).Code(); code != codes.InvalidArgument {
+			t.Fatalf("got code %v, want %v", code, codes.InvalidArgument)
+		}
+	}
+}
+
+// subscribedBroker closes subscribed once a watcher has subscribed
+type subscribedBroker struct {
+	*broker.Broker
+	subscribed chan struct{}
+}
+
+func (b *subscribedBroker) Subscribe(filter model.CargoUnitEventFilter) (<-chan model.CargoUnitEvent, func()) {
+	events, unsubscribe := b.Broker.Subscribe(filter)
+	close(b.subscribed)
+	return events, unsubscribe
+}
+
+// watch starts watching cargo units, sent events are passed to send, the returned channel receives error of the watch
+func watch(ctx context.Context, t *testing.T, bufferSize int, in *logistics_v1.WatchCargoUnitsRequest, send func(*logistics_v1.CargoUnitEvent) error) (*LogisticsEngine, *broker.Broker, <-chan error) {
+	t.Helper()
+
+	repository := memory.New()
+	b := &subscribedBroker{Broker: broker.New(bufferSize), subscribed: make(chan struct{})}
+	l := NewLogisticsEngine(slog.New(slog.NewTextHandler(io.Discard, nil)), repository, repository, b)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- l.WatchCargoUnits(ctx, in, send)
+	}()
+	<-b.subscribed
+	return l, b.Broker, done
+}
+
+func TestWatchCargoUnits(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sent := make(chan *logistics_v1.CargoUnitEvent, 10)
+	l, _, done := watch(ctx, t, 10, &logistics_v1.WatchCargoUnitsRequest{CargoUnitIds: []int64{1, 2}, WarehouseId: 5}, func(event *logistics_v1.CargoUnitEvent) error {
+		sent <- event
+		return nil
+	})
+
+	// only unit 2 reaching warehouse 5 matches
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+	for _, arrival := range []struct{ unit, warehouse int64 }{{1, 6}, {3, 5}, {2, 5}} {
+		_, err := l.UnitReachedWarehouse(ctx, &logistics_v1.UnitReachedWarehouseRequest{
+			Location:     &logistics_v1.Location{Latitude: 1},
+			Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: arrival.unit, WarehouseId: arrival.warehouse, Message: "arrived"},
+		})
+		if err != nil {
+			t.Fatalf("UnitReachedWarehouse() error = %v", err)
+		}
+	}
+
+	event := <-sent
+	if event.GetCargoUnitId() != 2 || event.GetUnitReachedWarehouse().GetAnnouncement().GetWarehouseId() != 5 || event.GetSequence() != 1 {
+		t.Fatalf("got event %v, want unit 2 reached warehouse 5", event)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("WatchCargoUnits() error = %v after the watcher left", err)
+	}
+	if len(sent) != 0 {
+		t.Fatalf("got %d more events, want none", len(sent))
+	}
+}
+
+func TestWatchCargoUnitsEnds(t *testing.T) {
+	tests := []struct {
+		name string
+		// end makes the watch end while the watcher is blocked sending
+		end  func(ctx context.Context, t *testing.T, l *LogisticsEngine, b *broker.Broker)
+		want error
+		code codes.Code
+	}{
+		{
+			name: "slow watcher",
+			end: func(ctx context.Context, t *testing.T, l *LogisticsEngine, b *broker.Broker) {
+				// the first event is being sent, the second one is buffered and the third does not fit
+				for i := 0; i < 3; i++ {
+					if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+						t.Fatalf("MoveUnit() error = %v", err)
+					}
+				}
+			},
+			want: ErrSubscriberTooSlow,
+			code: codes.ResourceExhausted,
+		},
+		{
+			name: "shutdown",
+			end: func(ctx context.Context, t *testing.T, l *LogisticsEngine, b *broker.Broker) {
+				b.Close()
+			},
+			want: ErrShuttingDown,
+			code: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			release := make(chan struct{})
+			l, b, done := watch(ctx, t, 1, &logistics_v1.WatchCargoUnitsRequest{}, func(*logistics_v1.CargoUnitEvent) error {
+				<-release
+				return nil
+			})
+
+			tt.end(ctx, t, l, b)
+			close(release)
+
+			err := <-done
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("WatchCargoUnits() error = %v, want %v", err, tt.want)
+			}
+			if code := ErrorStatus(err).Code(); code != tt.code {
+				t.Fatalf("got code %v, want %v", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	move := func(ifMatch string) error {
+		_, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 1}, IfMatch: ifMatch})
+		return err
+	}
+
+	if err := move(""); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+	unit, err := l.GetCargoUnit(ctx, &logistics_v1.GetCargoUnitRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnit() error = %v", err)
+	}
+	etag := unit.GetCargoUnit().GetEtag()
+
+	// the etag is accepted quoted the way HTTP ETag header carries it, once
+	if err := move(strconv.Quote(etag)); err != nil {
+		t.Fatalf("MoveUnit() error = %v with the current etag", err)
+	}
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    error
+		code    codes.Code
+	}{
+		{name: "stale etag", ifMatch: etag, want: repository.ErrConflict, code: codes.Aborted},
+		{name: "unit exists", ifMatch: "0", want: repository.ErrAlreadyExists, code: codes.AlreadyExists},
+		{name: "negative etag", ifMatch: "-1", want: ErrInvalidArgument, code: codes.InvalidArgument},
+		{name: "etag not issued", ifMatch: "abc", want: ErrInvalidArgument, code: codes.InvalidArgument},
+		{name: "weak etag", ifMatch: `W/"2"`, want: ErrInvalidArgument, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := move(tt.ifMatch)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("MoveUnit() error = %v, want %v", err, tt.want)
+			}
+			if code := ErrorStatus(err).Code(); code != tt.code {
+				t.Fatalf("got code %v, want %v", code, tt.code)
+			}
+		})
+	}
+
+	track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: 1})
+	if err != nil {
+		t.Fatalf("GetCargoUnitTrack() error = %v", err)
+	}
+	if len(track.GetTrack()) != 2 || track.GetEtag() == etag {
+		t.Fatalf("got %d locations and etag %s, want 2 locations and a new etag", len(track.GetTrack()), track.GetEtag())
+	}
+
+	// etag 0 creates a unit that does not exist yet
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 2, Location: &logistics_v1.Location{Latitude: 1}, IfMatch: "0"}); err != nil {
+		t.Fatalf("MoveUnit() error = %v creating the unit", err)
+	}
+}
+
+// batchResult is the expected result of a batch item, field is the violated field of invalid item
+type batchResult struct {
+	unit  int64
+	code  codes.Code
+	field string
+}
+
+func assertBatchResponse(t *testing.T, resp *logistics_v1.BatchResponse, want []batchResult) {
+	t.Helper()
+
+	if len(resp.GetResults()) != len(want) {
+		t.Fatalf("got %d results, want %d", len(resp.GetResults()), len(want))
+	}
+	var accepted int64
+	for i, result := range resp.GetResults() {
+		st := status.FromProto(result.GetStatus())
+		if result.GetIndex() != int32(i) || result.GetCargoUnitId() != want[i].unit || st.Code() != want[i].code {
+			t.Fatalf("got result %v, want index %d unit %d code %v", result, i, want[i].unit, want[i].code)
+		}
+		if st.Code() == codes.OK {
+			accepted++
+		}
+		if want[i].field == "" {
+			continue
+		}
+		var fields []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fields = append(fields, violation.GetField())
+				}
+			}
+		}
+		if !slices.Equal(fields, []string{want[i].field}) {
+			t.Fatalf("got violations of %v in result %d, want %s", fields, i, want[i].field)
+		}
+	}
+	if resp.GetAcceptedNumber() != accepted || resp.GetRejectedNumber() != int64(len(want))-accepted {
+		t.Fatalf("got %d accepted and %d rejected, want %d and %d", resp.GetAcceptedNumber(), resp.GetRejectedNumber(), accepted, int64(len(want))-accepted)
+	}
+}
+
+func TestBatchMoveUnits(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	if _, err := l.MoveUnit(ctx, &logistics_v1.MoveUnitRequest{CargoUnitId: 3, Location: &logistics_v1.Location{Latitude: 1}}); err != nil {
+		t.Fatalf("MoveUnit() error = %v", err)
+	}
+
+	// if_match of every request of unit 3 refers to its version before the batch
+	location := &logistics_v1.Location{Latitude: 2}
+	resp, err := l.BatchMoveUnits(ctx, &logistics_v1.BatchMoveUnitsRequest{Requests: []*logistics_v1.MoveUnitRequest{
+		{CargoUnitId: 1, Location: location},
+		{CargoUnitId: 0, Location: location},
+		{CargoUnitId: 1, Location: location},
+		{CargoUnitId: 3, Location: location, IfMatch: "1"},
+		{CargoUnitId: 3, Location: location, IfMatch: "1"},
+		{CargoUnitId: 3, Location: location, IfMatch: "2"},
+		{CargoUnitId: 2, Location: location, IfMatch: "x"},
+		{CargoUnitId: 4, Location: location, IfMatch: "0"},
+		{CargoUnitId: 3, Location: location, IfMatch: "0"},
+	}})
+	if err != nil {
+		t.Fatalf("BatchMoveUnits() error = %v", err)
+	}
+	assertBatchResponse(t, resp, []batchResult{
+		{unit: 1, code: codes.OK},
+		{unit: 0, code: codes.InvalidArgument, field: "requests[1].cargo_unit_id"},
+		{unit: 1, code: codes.OK},
+		{unit: 3, code: codes.OK},
+		{unit: 3, code: codes.OK},
+		{unit: 3, code: codes.Aborted},
+		{unit: 2, code: codes.InvalidArgument, field: "requests[6].if_match"},
+		{unit: 4, code: codes.OK},
+		{unit: 3, code: codes.AlreadyExists},
+	})
+
+	for id, want := range map[int64]int{1: 2, 3: 3, 4: 1} {
+		track, err := l.GetCargoUnitTrack(ctx, &logistics_v1.GetCargoUnitTrackRequest{CargoUnitId: id})
+		if err != nil {
+			t.Fatalf("GetCargoUnitTrack() error = %v", err)
+		}
+		if len(track.GetTrack()) != want {
+			t.Fatalf("got %d locations of unit %d, want %d", len(track.GetTrack()), id, want)
+		}
+	}
+	if _, err := l.GetCargoUnit(ctx, &logistics_v1.GetCargoUnitRequest{CargoUnitId: 2}); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetCargoUnit() error = %v, want %v for unit of rejected request", err, repository.ErrNotFound)
+	}
+}
+
+func TestBatchUnitReachedWarehouse(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLogisticsEngine()
+
+	arrival := func(unit, warehouse int64) *logistics_v1.UnitReachedWarehouseRequest {
+		return &logistics_v1.UnitReachedWarehouseRequest{
+			Location:     &logistics_v1.Location{Latitude: 1},
+			Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: unit, WarehouseId: warehouse, Message: "arrived"},
+		}
+	}
+	resp, err := l.BatchUnitReachedWarehouse(ctx, &logistics_v1.BatchUnitReachedWarehouseRequest{Requests: []*logistics_v1.UnitReachedWarehouseRequest{
+		arrival(1, 5),
+		arrival(1, 0),
+		arrival(2, 6),
+		arrival(1, 6),
+	}})
+	if err != nil {
+		t.Fatalf("BatchUnitReachedWarehouse() error = %v", err)
+	}
+	assertBatchResponse(t, resp, []batchResult{
+		{unit: 1, code: codes.OK},
+		{unit: 1, code: codes.InvalidArgument, field: "requests[1].announcement.warehouse_id"},
+		{unit: 2, code: codes.OK},
+		{unit: 1, code: codes.OK},
+	})
+
+	report, err := l.MetricsReport(ctx, &logistics_v1.MetricsReportRequest{})
+	if err != nil {
+		t.Fatalf("MetricsReport() error = %v", err)
+	}
+	got := make(map[int64]int64)
+	for _, total := range report.GetDeliveryUnitsEachWarehouseReceivedTotalNumber() {
+		got[total.GetWarehouseId()] = total.GetDeliveryUnitsNumber()
+	}
+	if want := map[int64]int64{5: 1, 6: 2}; !maps.Equal(got, want) {
+		t.Fatalf("got totals %v, want %v", got, want)
+	}
+}
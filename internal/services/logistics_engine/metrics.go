@@ -0,0 +1,114 @@
+package logistics_engine
+
+import (
+	"context"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// collectTimeout bounds counting the repository size on every scrape
+const collectTimeout = 5 * time.Second
+
+// metrics are the domain metrics of the service, counters count events written by this instance only,
+// gauges describe the whole repository
+type metrics struct {
+	arrivals        *prometheus.CounterVec
+	locationUpdates prometheus.Counter
+	reportDuration  prometheus.Histogram
+
+	cargoUnits     *prometheus.Desc
+	inTransitUnits *prometheus.Desc
+	events         *prometheus.Desc
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		arrivals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "logistics_warehouse_arrivals_total",
+			Help: "Number of cargo unit arrivals by warehouse.",
+		}, []string{"warehouse_id"}),
+		locationUpdates: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "logistics_location_updates_total",
+			Help: "Number of cargo unit locations reported, its rate is location updates per second.",
+		}),
+		reportDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "logistics_metrics_report_duration_seconds",
+			Help:    "Time of computing metrics report.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		cargoUnits: prometheus.NewDesc(
+			"logistics_cargo_units",
+			"Number of cargo units tracked.",
+			nil, nil,
+		),
+		inTransitUnits: prometheus.NewDesc(
+			"logistics_cargo_units_in_transit",
+			"Number of cargo units that have not reached any warehouse yet.",
+			nil, nil,
+		),
+		events: prometheus.NewDesc(
+			"logistics_repository_events",
+			"Number of cargo unit events kept by the repository.",
+			nil, nil,
+		),
+	}
+}
+
+// observe counts events written by the service
+func (m *metrics) observe(events []model.CargoUnitEvent) {
+	for _, event := range events {
+		switch event.Type {
+		case model.CargoUnitEventMoved:
+			m.locationUpdates.Inc()
+		case model.CargoUnitEventReachedWarehouse:
+			m.arrivals.WithLabelValues(strconv.FormatInt(event.UnitReachedWarehouse.Announcement.WarehouseId, 10)).Inc()
+		}
+	}
+}
+
+// Describe implements prometheus.Collector
+func (l *LogisticsEngine) Describe(ch chan<- *prometheus.Desc) {
+	l.metrics.arrivals.Describe(ch)
+	l.metrics.locationUpdates.Describe(ch)
+	l.metrics.reportDuration.Describe(ch)
+	ch <- l.metrics.cargoUnits
+	ch <- l.metrics.inTransitUnits
+	ch <- l.metrics.events
+}
+
+// Collect implements prometheus.Collector, it counts the units of the repository on every scrape
+func (l *LogisticsEngine) Collect(ch chan<- prometheus.Metric) {
+	const opLabel = "LogisticsEngine.Collect"
+
+	l.metrics.arrivals.Collect(ch)
+	l.metrics.locationUpdates.Collect(ch)
+	l.metrics.reportDuration.Collect(ch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	size, err := l.size(ctx)
+	if err != nil {
+		l.log.Error("failed to get repository size", slog.String("opLabel", opLabel), logging.Err(err))
+		for _, desc := range []*prometheus.Desc{l.metrics.cargoUnits, l.metrics.inTransitUnits, l.metrics.events} {
+			ch <- prometheus.NewInvalidMetric(desc, err)
+		}
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(l.metrics.cargoUnits, prometheus.GaugeValue, float64(size.CargoUnits))
+	ch <- prometheus.MustNewConstMetric(l.metrics.inTransitUnits, prometheus.GaugeValue, float64(size.InTransitUnits))
+	ch <- prometheus.MustNewConstMetric(l.metrics.events, prometheus.GaugeValue, float64(size.Events))
+}
+
+// size counts the units of the repository and their events
+func (l *LogisticsEngine) size(ctx context.Context) (model.RepositorySize, error) {
+	if aggregator, ok := l.rptProvider.(ReportAggregator); ok {
+		return aggregator.Size(ctx)
+	}
+	return l.aggregates.size(ctx, l.rptProvider)
+}
@@ -0,0 +1,214 @@
+package logistics_engine
+
+import (
+	"cmp"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"slices"
+	"time"
+)
+
+// earthRadiusMeters is the mean radius of the WGS84 ellipsoid, the repositories aggregating stats in SQL use it too
+const earthRadiusMeters = 6371008.8
+
+// haversine returns the great-circle distance between a and b in meters
+func haversine(a, b model.Location) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// travelStats returns stats of the track in its order. Speeds are computed from the segments between locations
+// of increasing Location.Time only, segments with a location without time are counted in the distance alone.
+func travelStats(track []model.Location) model.TravelStats {
+	var stats model.TravelStats
+	if len(track) == 0 {
+		return stats
+	}
+
+	for i := 1; i < len(track); i++ {
+		meters, seconds := segment(track[i-1], track[i])
+		stats.DistanceMeters += meters
+		if seconds > 0 {
+			stats.TimedDistanceMeters += meters
+			stats.TimedSeconds += seconds
+			stats.MaxSpeed = max(stats.MaxSpeed, meters/seconds)
+		}
+	}
+	stats.StraightLineMeters = haversine(track[0], track[len(track)-1])
+
+	return stats
+}
+
+// segment returns the distance between from and to, and the seconds between them if they are timed and in time order,
+// 0 seconds otherwise
+func segment(from, to model.Location) (meters, seconds float64) {
+	meters = haversine(from, to)
+	if from.Time().IsZero() || to.Time().IsZero() {
+		return meters, 0
+	}
+	return meters, max(to.Time().Sub(from.Time()).Seconds(), 0)
+}
+
+// advanceTravelStats returns stats of track, given stats of the track before the locations events moved the unit to
+// were inserted into it. Only the segments around the inserted locations are computed, locations are appended mostly
+// and late ones are found near the end of the track. The stats are computed from the whole track if a late location
+// splits the fastest segment.
+func advanceTravelStats(stats model.TravelStats, track []model.Location, events []model.CargoUnitEvent) model.TravelStats {
+	// the location of the last event is the last of the locations equal to it, as insertByTime inserts
+	// after the locations of the same time
+	inserted := make(map[int]bool)
+	var positions []int
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != model.CargoUnitEventMoved {
+			continue
+		}
+		j := len(track) - 1
+		for j >= 0 && (inserted[j] || !track[j].Equal(events[i].Location)) {
+			j--
+		}
+		if j < 0 {
+			return travelStats(track)
+		}
+		inserted[j] = true
+		positions = append(positions, j)
+	}
+	if len(positions) == 0 {
+		return stats
+	}
+	slices.Sort(positions)
+
+	for n := 0; n < len(positions); n++ {
+		// locations i to j are inserted between the locations that made a segment before
+		i, j := positions[n], positions[n]
+		for n+1 < len(positions) && positions[n+1] == j+1 {
+			n++
+			j++
+		}
+		if i > 0 && j < len(track)-1 {
+			meters, seconds := segment(track[i-1], track[j+1])
+			stats.DistanceMeters -= meters
+			if seconds > 0 {
+				if meters/seconds >= stats.MaxSpeed {
+					return travelStats(track)
+				}
+				stats.TimedDistanceMeters -= meters
+				stats.TimedSeconds -= seconds
+			}
+		}
+		for k := max(i, 1); k <= min(j+1, len(track)-1); k++ {
+			meters, seconds := segment(track[k-1], track[k])
+			stats.DistanceMeters += meters
+			if seconds > 0 {
+				stats.TimedDistanceMeters += meters
+				stats.TimedSeconds += seconds
+				stats.MaxSpeed = max(stats.MaxSpeed, meters/seconds)
+			}
+		}
+	}
+	stats.StraightLineMeters = haversine(track[0], track[len(track)-1])
+
+	return stats
+}
+
+func toTravelStats(stats model.TravelStats) *logistics_v1.TravelStats {
+	return &logistics_v1.TravelStats{
+		DistanceMeters:     stats.DistanceMeters,
+		StraightLineMeters: stats.StraightLineMeters,
+		PathRatio:          stats.PathRatio(),
+		AverageSpeed:       stats.AverageSpeed(),
+		MaxSpeed:           stats.MaxSpeed,
+	}
+}
+
+// warehouseStats returns stats of the visits in the time window [from, to) by warehouse, ordered by warehouse id.
+// Only visits of warehouseID are counted unless it is 0, its stats are returned even if no unit passed through it.
+func warehouseStats(visits []model.WarehouseVisit, warehouseID int64, from, to time.Time) []model.WarehouseStats {
+	stats := make(map[int64]*model.WarehouseStats)
+	dwells := make(map[int64][]time.Duration)
+	statsOf := func(id int64) *model.WarehouseStats {
+		if stats[id] == nil {
+			stats[id] = &model.WarehouseStats{WarehouseId: id}
+		}
+		return stats[id]
+	}
+	if warehouseID != 0 {
+		statsOf(warehouseID)
+	}
+
+	inWindow := func(t time.Time) bool {
+		return !t.IsZero() && !t.Before(from) && t.Before(to)
+	}
+	for _, visit := range visits {
+		id := visit.UnitReachedWarehouse.Announcement.WarehouseId
+		if warehouseID != 0 && id != warehouseID {
+			continue
+		}
+		arrived := inWindow(visit.ArrivedAt)
+		departed := inWindow(visit.DepartedAt)
+		staying := !visit.ArrivedAt.IsZero() && visit.ArrivedAt.Before(to) && (visit.DepartedAt.IsZero() || !visit.DepartedAt.Before(to))
+		if !arrived && !departed && !staying {
+			continue
+		}
+
+		s := statsOf(id)
+		if arrived {
+			s.Arrivals++
+		}
+		if departed {
+			s.Departures++
+			if !visit.ArrivedAt.IsZero() {
+				dwells[id] = append(dwells[id], visit.Dwell())
+			}
+		}
+		if staying {
+			s.Occupancy++
+		}
+	}
+
+	result := make([]model.WarehouseStats, 0, len(stats))
+	for id, s := range stats {
+		slices.Sort(dwells[id])
+		s.DwellP50 = percentile(dwells[id], 50)
+		s.DwellP90 = percentile(dwells[id], 90)
+		s.DwellP99 = percentile(dwells[id], 99)
+		result = append(result, *s)
+	}
+	slices.SortFunc(result, func(a, b model.WarehouseStats) int {
+		return cmp.Compare(a.WarehouseId, b.WarehouseId)
+	})
+
+	return result
+}
+
+// percentile returns the nearest-rank percentile p of sorted durations, 0 if there are none
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// toWarehouseStats converts stats of the time window, dwell percentiles are not set unless a visit ended in it
+func toWarehouseStats(stats model.WarehouseStats, window time.Duration) *logistics_v1.WarehouseStats {
+	s := &logistics_v1.WarehouseStats{
+		WarehouseId:     stats.WarehouseId,
+		InboundUnits:    stats.Arrivals,
+		OutboundUnits:   stats.Departures,
+		InboundPerHour:  float64(stats.Arrivals) / window.Hours(),
+		OutboundPerHour: float64(stats.Departures) / window.Hours(),
+		Occupancy:       stats.Occupancy,
+	}
+	if stats.Departures > 0 {
+		s.DwellP50 = durationpb.New(stats.DwellP50)
+		s.DwellP90 = durationpb.New(stats.DwellP90)
+		s.DwellP99 = durationpb.New(stats.DwellP99)
+	}
+	return s
+}
@@ -0,0 +1,289 @@
+package logistics_engine
+
+import (
+	"context"
+	"fmt"
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"github.com/ivanbulyk/logistics_engine_api/internal/logging"
+	"github.com/ivanbulyk/logistics_engine_api/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+)
+
+func (l *LogisticsEngine) MoveUnitV2(ctx context.Context, in *logistics_v2.MoveUnitRequest) (*logistics_v2.DefaultResponse, error) {
+	const opLabel = "LogisticsEngine.MoveUnitV2"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
+	)
+
+	version, err := parseIfMatch(in.GetIfMatch())
+	if err != nil {
+		log.Warn("invalid move unit data", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	log.Info("attempting to save metrics report with move unit data")
+
+	location := fromLocationV2(in.GetLocation(), time.Now())
+	event := model.CargoUnitEvent{
+		Type:        model.CargoUnitEventMoved,
+		CargoUnitId: in.GetCargoUnitId(),
+		Location:    location,
+		OccurredAt:  location.Time(),
+		ReceivedAt:  location.ReceivedAt,
+	}
+
+	if err := l.appendEvents(ctx, in.GetCargoUnitId(), decideIfMatch(version, event)); err != nil {
+		log.Error("failed to save metrics report", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v2.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) UnitReachedWarehouseV2(ctx context.Context, in *logistics_v2.UnitReachedWarehouseRequest) (*logistics_v2.DefaultResponse, error) {
+	const opLabel = "LogisticsEngine.UnitReachedWarehouseV2"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetAnnouncement().GetCargoUnitId(), 10)),
+	)
+
+	version, err := parseIfMatch(in.GetIfMatch())
+	if err != nil {
+		log.Warn("invalid unit reached warehouse data", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	log.Info("attempting to save metrics report with unit reached warehouse data")
+
+	location := fromLocationV2(in.GetLocation(), time.Now())
+	event := model.CargoUnitEvent{
+		Type:        model.CargoUnitEventReachedWarehouse,
+		CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+		UnitReachedWarehouse: model.UnitReachedWarehouse{
+			Location: location,
+			Announcement: model.WarehouseAnnouncement{
+				CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+				WarehouseId: in.GetAnnouncement().GetWarehouseId(),
+				Message:     in.GetAnnouncement().GetMessage(),
+			},
+		},
+		OccurredAt: location.Time(),
+		ReceivedAt: location.ReceivedAt,
+	}
+
+	if err := l.appendEvents(ctx, in.GetAnnouncement().GetCargoUnitId(), decideIfMatch(version, event)); err != nil {
+		log.Error("failed to save metrics report", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v2.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) UnitDepartedWarehouseV2(ctx context.Context, in *logistics_v2.UnitDepartedWarehouseRequest) (*logistics_v2.DefaultResponse, error) {
+	const opLabel = "LogisticsEngine.UnitDepartedWarehouseV2"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetAnnouncement().GetCargoUnitId(), 10)),
+	)
+
+	version, err := parseIfMatch(in.GetIfMatch())
+	if err != nil {
+		log.Warn("invalid unit departed warehouse data", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	log.Info("attempting to save metrics report with unit departed warehouse data")
+
+	location := fromLocationV2(in.GetLocation(), time.Now())
+	event := model.CargoUnitEvent{
+		Type:        model.CargoUnitEventDepartedWarehouse,
+		CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+		UnitDepartedWarehouse: model.UnitDepartedWarehouse{
+			Location: location,
+			Announcement: model.WarehouseAnnouncement{
+				CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+				WarehouseId: in.GetAnnouncement().GetWarehouseId(),
+				Message:     in.GetAnnouncement().GetMessage(),
+			},
+		},
+		OccurredAt: location.Time(),
+		ReceivedAt: location.ReceivedAt,
+	}
+
+	if err := l.appendEvents(ctx, in.GetAnnouncement().GetCargoUnitId(), decideDeparture(version, event)); err != nil {
+		log.Error("failed to save metrics report", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	return &logistics_v2.DefaultResponse{}, nil
+}
+
+func (l *LogisticsEngine) GetCargoUnitTrackV2(ctx context.Context, in *logistics_v2.GetCargoUnitTrackRequest) (*logistics_v2.GetCargoUnitTrackResponse, error) {
+	const opLabel = "LogisticsEngine.GetCargoUnitTrackV2"
+
+	log := l.log.With(
+		slog.String("opLabel", opLabel),
+		slog.String("CargoUnitId", strconv.FormatInt(in.GetCargoUnitId(), 10)),
+	)
+
+	log.Info("attempting to get cargo unit track")
+
+	report, err := l.rptProvider.GetByID(ctx, in.GetCargoUnitId())
+	if err != nil {
+		log.Error("failed to get cargo unit track", logging.Err(err))
+		return nil, fmt.Errorf("%s: %w", opLabel, err)
+	}
+
+	track := make([]*logistics_v2.Location, 0, len(report.MoveUnit.Location))
+	for _, location := range report.MoveUnit.Location {
+		track = append(track, toLocationV2(location))
+	}
+
+	resp := &logistics_v2.GetCargoUnitTrackResponse{
+		CargoUnitId:     report.ID,
+		Track:           track,
+		Etag:            etag(report.Version),
+		WarehouseVisits: warehouseVisitsV2(report),
+	}
+	if n := len(report.MoveUnit.Location); n > 0 {
+		resp.LastLocation = track[n-1]
+	} else if report.ReachedWarehouse() {
+		resp.LastLocation = toLocationV2(report.UnitReachedWarehouse.Location)
+	}
+	if report.ReachedWarehouse() {
+		resp.WarehouseArrival = toWarehouseArrivalV2(report.UnitReachedWarehouse)
+	}
+
+	return resp, nil
+}
+
+func warehouseVisitsV2(report model.MetricsReport) []*logistics_v2.WarehouseVisit {
+	visits := make([]*logistics_v2.WarehouseVisit, 0, len(report.Visits()))
+	for _, visit := range report.Visits() {
+		v := &logistics_v2.WarehouseVisit{
+			Arrival: toWarehouseArrivalV2(visit.UnitReachedWarehouse),
+		}
+		if !visit.ArrivedAt.IsZero() {
+			v.ArrivedAt = timestamppb.New(visit.ArrivedAt)
+		}
+		if !visit.DepartedAt.IsZero() {
+			v.DepartedAt = timestamppb.New(visit.DepartedAt)
+		}
+		visits = append(visits, v)
+	}
+	return visits
+}
+
+func toWarehouseArrivalV2(arrival model.UnitReachedWarehouse) *logistics_v2.WarehouseArrival {
+	return &logistics_v2.WarehouseArrival{
+		Location: toLocationV2(arrival.Location),
+		Announcement: &logistics_v2.WarehouseAnnouncement{
+			CargoUnitId: arrival.Announcement.CargoUnitId,
+			WarehouseId: arrival.Announcement.WarehouseId,
+			Message:     arrival.Announcement.Message,
+		},
+	}
+}
+
+func toLocationV2(location model.Location) *logistics_v2.Location {
+	l := &logistics_v2.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Altitude:  location.Altitude,
+		Accuracy:  location.Accuracy,
+		Heading:   location.Heading,
+		Speed:     location.Speed,
+	}
+	if !location.RecordedAt.IsZero() {
+		l.RecordedAt = timestamppb.New(location.RecordedAt)
+	}
+	if !location.ReceivedAt.IsZero() {
+		l.ReceivedAt = timestamppb.New(location.ReceivedAt)
+	}
+	return l
+}
+
+// fromLocationV2 converts the requested location received at receivedAt, received_at of the request is ignored
+func fromLocationV2(location *logistics_v2.Location, receivedAt time.Time) model.Location {
+	l := model.Location{
+		Latitude:   location.GetLatitude(),
+		Longitude:  location.GetLongitude(),
+		Altitude:   location.Altitude,
+		Accuracy:   location.Accuracy,
+		Heading:    location.Heading,
+		Speed:      location.Speed,
+		ReceivedAt: receivedAt,
+	}
+	if location.GetRecordedAt() != nil {
+		l.RecordedAt = location.GetRecordedAt().AsTime()
+	}
+	return l
+}
+
+// v1 requests are served by v2, v1 locations are whole degrees of the northern and eastern hemispheres
+
+func moveUnitRequestV2(in *logistics_v1.MoveUnitRequest) *logistics_v2.MoveUnitRequest {
+	return &logistics_v2.MoveUnitRequest{
+		CargoUnitId: in.GetCargoUnitId(),
+		Location:    locationV2(in.GetLocation()),
+		IfMatch:     in.GetIfMatch(),
+	}
+}
+
+func unitReachedWarehouseRequestV2(in *logistics_v1.UnitReachedWarehouseRequest) *logistics_v2.UnitReachedWarehouseRequest {
+	return &logistics_v2.UnitReachedWarehouseRequest{
+		Location: locationV2(in.GetLocation()),
+		Announcement: &logistics_v2.WarehouseAnnouncement{
+			CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+			WarehouseId: in.GetAnnouncement().GetWarehouseId(),
+			Message:     in.GetAnnouncement().GetMessage(),
+		},
+		IfMatch: in.GetIfMatch(),
+	}
+}
+
+func unitDepartedWarehouseRequestV2(in *logistics_v1.UnitDepartedWarehouseRequest) *logistics_v2.UnitDepartedWarehouseRequest {
+	return &logistics_v2.UnitDepartedWarehouseRequest{
+		Location: locationV2(in.GetLocation()),
+		Announcement: &logistics_v2.WarehouseAnnouncement{
+			CargoUnitId: in.GetAnnouncement().GetCargoUnitId(),
+			WarehouseId: in.GetAnnouncement().GetWarehouseId(),
+			Message:     in.GetAnnouncement().GetMessage(),
+		},
+		IfMatch: in.GetIfMatch(),
+	}
+}
+
+func locationV2(location *logistics_v1.Location) *logistics_v2.Location {
+	if location == nil {
+		return nil
+	}
+	return &logistics_v2.Location{
+		Latitude:   float64(location.GetLatitude()),
+		Longitude:  float64(location.GetLongitude()),
+		RecordedAt: location.GetRecordedAt(),
+	}
+}
+
+func locationV1(location *logistics_v2.Location) *logistics_v1.Location {
+	return &logistics_v1.Location{
+		Latitude:   wholeDegrees(location.GetLatitude()),
+		Longitude:  wholeDegrees(location.GetLongitude()),
+		RecordedAt: location.GetRecordedAt(),
+		ReceivedAt: location.GetReceivedAt(),
+	}
+}
+
+// wholeDegrees rounds degrees to the nearest whole degree, degrees of the southern and western hemispheres are clamped to 0
+func wholeDegrees(degrees float64) uint32 {
+	return uint32(math.Round(math.Max(degrees, 0)))
+}
@@ -0,0 +1,134 @@
+package logistics_engine
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validator is implemented by messages with rules declared in api/v1 and api/v2 logistics.proto
+type validator interface {
+	ValidateAll() error
+}
+
+// pgvError is implemented by validation errors generated by protoc-gen-validate
+type pgvError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// pgvMultiError is implemented by errors aggregating pgvError
+type pgvMultiError interface {
+	AllErrors() []error
+}
+
+// Validate checks msg against its declared rules and returns ValidationError
+// with a violation per invalid field, named by its path in the proto message.
+func Validate(msg proto.Message) error {
+	v, ok := msg.(validator)
+	if !ok {
+		return nil
+	}
+
+	var violations []FieldViolation
+	if err := v.ValidateAll(); err != nil {
+		violations = fieldViolations(msg.ProtoReflect().Descriptor(), "", err)
+		if len(violations) == 0 {
+			violations = []FieldViolation{{Field: "", Description: err.Error()}}
+		}
+	}
+	violations = append(violations, nonFiniteViolations(msg.ProtoReflect(), "")...)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: violations}
+}
+
+// nonFiniteViolations reports NaN and infinite floating point fields of m and its messages,
+// NaN passes any bound declared in the proto. Repeated fields are validated item by item.
+func nonFiniteViolations(m protoreflect.Message, prefix string) []FieldViolation {
+	var violations []FieldViolation
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsList() || fd.IsMap():
+		case fd.Kind() == protoreflect.DoubleKind || fd.Kind() == protoreflect.FloatKind:
+			if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+				violations = append(violations, FieldViolation{Field: path, Description: "value must be a finite number"})
+			}
+		case fd.Message() != nil:
+			violations = append(violations, nonFiniteViolations(v.Message(), path+".")...)
+		}
+		return true
+	})
+	return violations
+}
+
+func fieldViolations(md protoreflect.MessageDescriptor, prefix string, err error) []FieldViolation {
+	var multiErr pgvMultiError
+	if errors.As(err, &multiErr) {
+		var violations []FieldViolation
+		for _, e := range multiErr.AllErrors() {
+			violations = append(violations, fieldViolations(md, prefix, e)...)
+		}
+		return violations
+	}
+
+	var fieldErr pgvError
+	if !errors.As(err, &fieldErr) {
+		return []FieldViolation{{Field: strings.TrimSuffix(prefix, "."), Description: err.Error()}}
+	}
+
+	// repeated field items are reported as Field[index]
+	goName, index, _ := strings.Cut(fieldErr.Field(), "[")
+	if index != "" {
+		index = "[" + index
+	}
+
+	path := prefix + goName + index
+	fd := fieldByGoName(md, goName)
+	if fd != nil {
+		path = prefix + string(fd.Name()) + index
+	}
+
+	if fieldErr.Cause() != nil && fd != nil && fd.Message() != nil {
+		return fieldViolations(fd.Message(), path+".", fieldErr.Cause())
+	}
+
+	return []FieldViolation{{Field: path, Description: fieldErr.Reason()}}
+}
+
+// fieldByGoName returns field of md with generated Go struct field name goName
+func fieldByGoName(md protoreflect.MessageDescriptor, goName string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if goCamelCase(string(fields.Get(i).Name())) == goName {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+// goCamelCase converts proto field name the way protoc-gen-go names struct fields
+func goCamelCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
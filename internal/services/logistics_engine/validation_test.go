@@ -0,0 +1,86 @@
+package logistics_engine
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	logistics_v1 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v1"
+	logistics_v2 "github.com/ivanbulyk/logistics_engine_api/internal/generated/logistics/api/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidate(t *testing.T) {
+	announcement := &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: "arrived"}
+
+	tests := []struct {
+		name string
+		msg  proto.Message
+		// fields are the paths of the violated fields, none if msg is valid
+		fields []string
+	}{
+		{name: "valid move", msg: &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 90, Longitude: 180}}},
+		{name: "cargo unit 0", msg: &logistics_v1.MoveUnitRequest{Location: &logistics_v1.Location{}}, fields: []string{"cargo_unit_id"}},
+		{name: "no location", msg: &logistics_v1.MoveUnitRequest{CargoUnitId: 1}, fields: []string{"location"}},
+		{name: "latitude out of range", msg: &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{Latitude: 91}}, fields: []string{"location.Latitude"}},
+		{name: "etag too long", msg: &logistics_v1.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v1.Location{}, IfMatch: strings.Repeat("1", 33)}, fields: []string{"if_match"}},
+		{
+			name:   "every violation reported",
+			msg:    &logistics_v1.MoveUnitRequest{Location: &logistics_v1.Location{Latitude: 91, Longitude: 181}},
+			fields: []string{"cargo_unit_id", "location.Latitude", "location.Longitude"},
+		},
+		{name: "valid arrival", msg: &logistics_v1.UnitReachedWarehouseRequest{Location: &logistics_v1.Location{}, Announcement: announcement}},
+		{
+			name:   "warehouse 0",
+			msg:    &logistics_v1.UnitReachedWarehouseRequest{Location: &logistics_v1.Location{}, Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1}},
+			fields: []string{"announcement.warehouse_id"},
+		},
+		{
+			name:   "message too long",
+			msg:    &logistics_v1.UnitReachedWarehouseRequest{Location: &logistics_v1.Location{}, Announcement: &logistics_v1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 5, Message: strings.Repeat("a", 1025)}},
+			fields: []string{"announcement.message"},
+		},
+		{name: "no announcement", msg: &logistics_v1.UnitDepartedWarehouseRequest{Location: &logistics_v1.Location{}}, fields: []string{"announcement"}},
+		{name: "repeated item", msg: &logistics_v1.WatchCargoUnitsRequest{CargoUnitIds: []int64{1, 0}}, fields: []string{"cargo_unit_ids[1]"}},
+		{name: "empty batch", msg: &logistics_v1.BatchMoveUnitsRequest{}, fields: []string{"requests"}},
+		// requests of a batch are validated one by one by the batch
+		{name: "batch with invalid request", msg: &logistics_v1.BatchMoveUnitsRequest{Requests: []*logistics_v1.MoveUnitRequest{{}}}},
+		{name: "undefined enum", msg: &logistics_v1.MetricsReportRequest{GroupBy: 10}, fields: []string{"group_by"}},
+		{name: "valid v2 move", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Latitude: -90, Longitude: 180, Heading: proto.Float64(359.9)}}},
+		{name: "v2 latitude out of range", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Latitude: -90.5}}, fields: []string{"location.latitude"}},
+		{name: "v2 longitude out of range", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Longitude: 180.1}}, fields: []string{"location.longitude"}},
+		{name: "NaN latitude", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Latitude: math.NaN()}}, fields: []string{"location.latitude"}},
+		{name: "infinite altitude", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Altitude: proto.Float64(math.Inf(1))}}, fields: []string{"location.altitude"}},
+		{name: "full circle heading", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Heading: proto.Float64(360)}}, fields: []string{"location.heading"}},
+		{name: "negative speed", msg: &logistics_v2.MoveUnitRequest{CargoUnitId: 1, Location: &logistics_v2.Location{Speed: proto.Float64(-1)}}, fields: []string{"location.speed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.msg)
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("Validate() error = %v, want %T", err, validationErr)
+			}
+			var fields []string
+			for _, v := range validationErr.Violations {
+				if v.Description == "" {
+					t.Fatalf("got violation of %s without description", v.Field)
+				}
+				fields = append(fields, v.Field)
+			}
+			slices.Sort(fields)
+			if !slices.Equal(fields, tt.fields) {
+				t.Fatalf("got violations of %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}